@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func binaryBenchmarkPath() *Path {
+	p := NewPath()
+	for i := 0; i < 10000; i++ {
+		f := float64(i)
+		p.Push(NewPoint(-122.4+0.0001*f+0.00003*math.Sin(f), 37.7+0.0001*f*math.Cos(f/100)))
+	}
+
+	return p
+}
+
+func BenchmarkPathMarshalBinary(b *testing.B) {
+	p := binaryBenchmarkPath()
+	data, _ := p.MarshalBinary()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.MarshalBinary()
+	}
+}
+
+func BenchmarkPathUnmarshalBinary(b *testing.B) {
+	data, _ := binaryBenchmarkPath().MarshalBinary()
+	p := NewPath()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.UnmarshalBinary(data)
+	}
+}
+
+func BenchmarkPathMarshalJSON(b *testing.B) {
+	p := binaryBenchmarkPath()
+	data, _ := json.Marshal(p)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		json.Marshal(p)
+	}
+}
+
+func BenchmarkPathUnmarshalJSON(b *testing.B) {
+	data, _ := json.Marshal(binaryBenchmarkPath())
+	p := NewPath()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		json.Unmarshal(data, p)
+	}
+}
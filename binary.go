@@ -0,0 +1,99 @@
+package geo
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// binaryVersion is the first byte of all binary encoded data.
+const binaryVersion = 1
+
+// ErrInvalidBinary is returned when unmarshalling binary data that is
+// truncated, corrupted or of an unsupported version.
+var ErrInvalidBinary = errors.New("go.geo: invalid binary data")
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The layout is a version byte followed by x and y as little endian float64s.
+func (p Point) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 1+16)
+	data[0] = binaryVersion
+	putPoint(data[1:], p)
+
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Returns ErrInvalidBinary if the data is not the output of Point.MarshalBinary.
+func (p *Point) UnmarshalBinary(data []byte) error {
+	if len(data) != 1+16 || data[0] != binaryVersion {
+		return ErrInvalidBinary
+	}
+
+	*p = readPoint(data[1:])
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The layout is a version byte, the number of points as a uvarint,
+// followed by the x, y pairs as little endian float64s.
+func (p *Path) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 1+binary.MaxVarintLen64+16*len(p.PointSet))
+	data[0] = binaryVersion
+
+	offset := 1 + binary.PutUvarint(data[1:], uint64(len(p.PointSet)))
+	for _, point := range p.PointSet {
+		putPoint(data[offset:], point)
+		offset += 16
+	}
+
+	return data[:offset], nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Returns ErrInvalidBinary if the data is not the output of Path.MarshalBinary.
+func (p *Path) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != binaryVersion {
+		return ErrInvalidBinary
+	}
+
+	count, n := binary.Uvarint(data[1:])
+	if n <= 0 {
+		return ErrInvalidBinary
+	}
+
+	data = data[1+n:]
+	if count != uint64(len(data)/16) || len(data)%16 != 0 {
+		return ErrInvalidBinary
+	}
+
+	ps := make(PointSet, count)
+	for i := range ps {
+		ps[i] = readPoint(data[16*i:])
+	}
+
+	p.PointSet = ps
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface using MarshalBinary.
+func (p *Path) GobEncode() ([]byte, error) {
+	return p.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface using UnmarshalBinary.
+func (p *Path) GobDecode(data []byte) error {
+	return p.UnmarshalBinary(data)
+}
+
+func putPoint(data []byte, p Point) {
+	binary.LittleEndian.PutUint64(data, math.Float64bits(p[0]))
+	binary.LittleEndian.PutUint64(data[8:], math.Float64bits(p[1]))
+}
+
+func readPoint(data []byte) Point {
+	return Point{
+		math.Float64frombits(binary.LittleEndian.Uint64(data)),
+		math.Float64frombits(binary.LittleEndian.Uint64(data[8:])),
+	}
+}
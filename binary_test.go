@@ -0,0 +1,126 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestPointBinary(t *testing.T) {
+	p1 := NewPoint(-122.41942, 37.77493)
+
+	data, err := p1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	if len(data) != 17 {
+		t.Errorf("binary encoding should be 17 bytes, got %d", len(data))
+	}
+
+	p2 := &Point{}
+	if err := p2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if !p1.Equals(p2) {
+		t.Errorf("unmarshal incorrect, got %v", p2)
+	}
+
+	// special values survive
+	p1 = NewPoint(math.Inf(-1), -0.0)
+	data, _ = p1.MarshalBinary()
+	p2.UnmarshalBinary(data)
+	if !p1.Equals(p2) {
+		t.Errorf("unmarshal incorrect, got %v", p2)
+	}
+
+	// errors
+	errorTests := [][]byte{
+		nil,
+		{},
+		data[:16],
+		append(append([]byte{}, data...), 0),
+		append([]byte{2}, data[1:]...),
+	}
+
+	for i, test := range errorTests {
+		if err := p2.UnmarshalBinary(test); err != ErrInvalidBinary {
+			t.Errorf("point, test %d should return invalid binary error, got %v", i, err)
+		}
+	}
+}
+
+func TestPathBinary(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551)).
+		Push(NewPoint(-122.41758, 37.77637))
+
+	data, err := p1.MarshalBinary()
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	if len(data) != 1+1+3*16 {
+		t.Errorf("binary encoding should be %d bytes, got %d", 1+1+3*16, len(data))
+	}
+
+	p2 := NewPath()
+	if err := p2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if !p1.Equals(p2) {
+		t.Errorf("unmarshal incorrect, got %v", p2)
+	}
+
+	// empty path
+	empty, _ := NewPath().MarshalBinary()
+	if err := p2.UnmarshalBinary(empty); err != nil || p2.Length() != 0 {
+		t.Errorf("empty path should unmarshal to empty path, got %v %v", p2, err)
+	}
+
+	// every truncation must error
+	for i := 0; i < len(data); i++ {
+		if err := p2.UnmarshalBinary(data[:i]); err != ErrInvalidBinary {
+			t.Errorf("path, truncated to %d bytes should return invalid binary error, got %v", i, err)
+		}
+	}
+
+	// corrupted data
+	errorTests := [][]byte{
+		append([]byte{2}, data[1:]...),
+		append(append([]byte{}, data...), 0),
+		{binaryVersion, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		{binaryVersion, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		{binaryVersion, 5, 1, 2, 3},
+	}
+
+	for i, test := range errorTests {
+		if err := p2.UnmarshalBinary(test); err != ErrInvalidBinary {
+			t.Errorf("path, test %d should return invalid binary error, got %v", i, err)
+		}
+	}
+}
+
+func TestPathGob(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(1, 2)).
+		Push(NewPoint(3, 4))
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(p1); err != nil {
+		t.Fatalf("should gob encode just fine, %v", err)
+	}
+
+	p2 := NewPath()
+	if err := gob.NewDecoder(&buf).Decode(p2); err != nil {
+		t.Fatalf("should gob decode just fine, %v", err)
+	}
+
+	if !p1.Equals(p2) {
+		t.Errorf("gob decode incorrect, got %v", p2)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math"
 	"strings"
+
+	"github.com/paulmach/go.geojson"
 )
 
 // A Bound represents an enclosed "box" in the 2D Euclidean or Cartesian plane.
@@ -32,8 +34,23 @@ func NewBoundFromPoints(corner, oppositeCorner *Point) *Bound {
 	return b
 }
 
+// NewBoundAroundPoint creates a new bound given a center point,
+// and a distance from the center point. Assumes euclidean geometry.
+func NewBoundAroundPoint(center *Point, distance float64) *Bound {
+	if distance < 0 {
+		panic("invalid distance around center")
+	}
+
+	return &Bound{
+		sw: &Point{center[0] - distance, center[1] - distance},
+		ne: &Point{center[0] + distance, center[1] + distance},
+	}
+}
+
 // NewGeoBoundAroundPoint creates a new bound given a center point,
-// and a distance from the center point in meters
+// and a distance from the center point in meters.
+// The longitude span widens with latitude. If the bound would cross a pole or
+// the anti-meridian, it is clamped to the valid latitudes and spans all longitudes.
 func NewGeoBoundAroundPoint(center *Point, distance float64) *Bound {
 	if distance < 0 {
 		panic("invalid distance around center")
@@ -63,27 +80,25 @@ func NewBoundFromMapTile(x, y, z uint64) *Bound {
 	}
 }
 
-// NewBoundFromGeoHash creates a new bound for the region defined by the GeoHash.
-func NewBoundFromGeoHash(hash string) *Bound {
-	west, east, south, north := geoHash2ranges(hash)
-	return NewBound(west, east, south, north)
-}
-
-// NewBoundFromGeoHashInt64 creates a new bound from the region defined by the GeoHesh.
-// bits indicates the precision of the hash.
-func NewBoundFromGeoHashInt64(hash int64, bits int) *Bound {
-	west, east, south, north := geoHashInt2ranges(hash, bits)
-	return NewBound(west, east, south, north)
+// NewBoundFromQuadkey creates a new bound for the map tile of the quadkey.
+// The north west corner is the point from NewPointFromQuadkey.
+// See http://msdn.microsoft.com/en-us/library/bb259689.aspx for more information
+// about this coordinate system.
+func NewBoundFromQuadkey(key int64, level int) *Bound {
+	x, y := quadkeyTile(key, level)
+	return NewBoundFromMapTile(x, y, uint64(level))
 }
 
-func geoHash2ranges(hash string) (float64, float64, float64, float64) {
+// NewBoundFromGeoHash creates a new bound for the region defined by the GeoHash,
+// the whole geohash cell and not just its center, see NewPointFromGeoHash.
+// An empty hash is the whole world.
+func NewBoundFromGeoHash(hash string) *Bound {
 	latMin, latMax := -90.0, 90.0
 	lngMin, lngMax := -180.0, 180.0
 	even := true
 
 	for _, r := range hash {
-		// TODO: index step could probably be done better
-		i := strings.Index("0123456789bcdefghjkmnpqrstuvwxyz", string(r))
+		i := strings.IndexRune(base32, r)
 		for j := 0x10; j != 0; j >>= 1 {
 			if even {
 				mid := (lngMin + lngMax) / 2.0
@@ -104,7 +119,7 @@ func geoHash2ranges(hash string) (float64, float64, float64, float64) {
 		}
 	}
 
-	return lngMin, lngMax, latMin, latMax
+	return NewBound(lngMin, lngMax, latMin, latMax)
 }
 
 func geoBoundAroundPoint(center *Point, distance float64) *Bound {
@@ -118,12 +133,12 @@ func geoBoundAroundPoint(center *Point, distance float64) *Bound {
 	if minLat > minLatitude && maxLat < maxLatitude {
 		deltaLon := math.Asin(math.Sin(radDist) / math.Cos(radLat))
 		minLon = radLon - deltaLon
-		if minLon < minLongitude {
-			minLon += 2 * math.Pi
-		}
 		maxLon = radLon + deltaLon
-		if maxLon > maxLongitude {
-			maxLon -= 2 * math.Pi
+
+		// bounds do not know about the anti-meridian
+		if minLon < minLongitude || maxLon > maxLongitude {
+			minLon = minLongitude
+			maxLon = maxLongitude
 		}
 	} else {
 		minLat = math.Max(minLat, minLatitude)
@@ -137,7 +152,9 @@ func geoBoundAroundPoint(center *Point, distance float64) *Bound {
 	}
 }
 
-func geoHashInt2ranges(hash int64, bits int) (float64, float64, float64, float64) {
+// NewBoundFromGeoHashInt64 creates a new bound from the region defined by the GeoHesh.
+// bits indicates the precision of the hash.
+func NewBoundFromGeoHashInt64(hash int64, bits int) *Bound {
 	latMin, latMax := -90.0, 90.0
 	lngMin, lngMax := -180.0, 180.0
 
@@ -163,7 +180,7 @@ func geoHashInt2ranges(hash int64, bits int) (float64, float64, float64, float64
 		}
 	}
 
-	return lngMin, lngMax, latMin, latMax
+	return NewBound(lngMin, lngMax, latMin, latMax)
 }
 
 // Set allows for the modification of the bound values in place.
@@ -175,7 +192,9 @@ func (b *Bound) Set(west, east, south, north float64) {
 	b.ne[1] = north
 }
 
-// Extend grows the bound to include the new point.
+// Extend grows the bound to include the new point, in place, and is chainable,
+// e.g. NewBoundFromPoints(p1, p1).Extend(p2).Extend(p3).
+// Use b.Clone().Extend(p) to keep the original.
 func (b *Bound) Extend(point *Point) *Bound {
 
 	// already included, no big deal
@@ -193,15 +212,39 @@ func (b *Bound) Extend(point *Point) *Bound {
 }
 
 // Union extends this bounds to contain the union of this and the given bounds.
+// Use b.Clone().Union(other) to keep the original.
 func (b *Bound) Union(other *Bound) *Bound {
-	b.Extend(other.SouthWest())
-	b.Extend(other.NorthWest())
-	b.Extend(other.SouthEast())
-	b.Extend(other.NorthEast())
+	b.sw[0] = math.Min(b.sw[0], other.sw[0])
+	b.sw[1] = math.Min(b.sw[1], other.sw[1])
+	b.ne[0] = math.Max(b.ne[0], other.ne[0])
+	b.ne[1] = math.Max(b.ne[1], other.ne[1])
+
+	return b
+}
+
+// ExtendFromPath grows the bound to include all the points of the path.
+func (b *Bound) ExtendFromPath(path *Path) *Bound {
+	for i := range path.PointSet {
+		b.Extend(&path.PointSet[i])
+	}
 
 	return b
 }
 
+// Intersection returns a new bound of the area shared by the two bounds
+// and true, or nil and false if they do not intersect. Like Intersects,
+// touching bounds intersect in a bound with zero width or height.
+func (b *Bound) Intersection(other *Bound) (*Bound, bool) {
+	if !b.Intersects(other) {
+		return nil, false
+	}
+
+	return &Bound{
+		sw: &Point{math.Max(b.sw[0], other.sw[0]), math.Max(b.sw[1], other.sw[1])},
+		ne: &Point{math.Min(b.ne[0], other.ne[0]), math.Min(b.ne[1], other.ne[1])},
+	}, true
+}
+
 // Contains determines if the point is within the bound.
 // Points on the boundary are considered within.
 func (b *Bound) Contains(point *Point) bool {
@@ -217,6 +260,19 @@ func (b *Bound) Contains(point *Point) bool {
 	return true
 }
 
+// IntersectsPoint determines if the point, as a zero size bound, intersects the bound.
+// Same as Contains, points on the boundary intersect.
+func (b *Bound) IntersectsPoint(point *Point) bool {
+	return b.Contains(point)
+}
+
+// ContainsBound determines if the other bound is within the bound.
+// Bounds sharing edges are considered within, so equal bounds contain each other.
+func (b *Bound) ContainsBound(other *Bound) bool {
+	return b.sw[0] <= other.sw[0] && other.ne[0] <= b.ne[0] &&
+		b.sw[1] <= other.sw[1] && other.ne[1] <= b.ne[1]
+}
+
 // Intersects determines if two bounds intersect.
 // Returns true if they are touching.
 func (b *Bound) Intersects(bound *Bound) bool {
@@ -231,7 +287,9 @@ func (b *Bound) Intersects(bound *Bound) bool {
 	return true
 }
 
-// Center returns the center of the bound.
+// Center returns the center of the bound, the midpoint of the corners. This is the
+// point NewPointFromGeoHash returns for the bound of a geohash. See GeoBound.Center
+// for bounds crossing the antimeridian.
 func (b *Bound) Center() *Point {
 	p := &Point{}
 	p.SetX((b.ne.X() + b.sw.X()) / 2.0)
@@ -241,30 +299,46 @@ func (b *Bound) Center() *Point {
 }
 
 // Pad expands the bound in all directions by the amount given. The amount must be
-// in the units of the bounds. Technically one can pad with negative value,
-// but no error checking is done.
+// in the units of the bounds. Negative values shrink the bound, down to a zero
+// size bound at the center.
 func (b *Bound) Pad(amount float64) *Bound {
-	b.sw.SetX(b.sw.X() - amount)
-	b.sw.SetY(b.sw.Y() - amount)
-
-	b.ne.SetX(b.ne.X() + amount)
-	b.ne.SetY(b.ne.Y() + amount)
+	return b.pad(amount, amount)
+}
 
-	return b
+// PadPercent expands each side of the bound by the given percent of its
+// width or height, eg. 10 grows a 100 wide bound to 120. Negative values shrink
+// the bound, down to a zero size bound at the center.
+func (b *Bound) PadPercent(percent float64) *Bound {
+	return b.pad(b.Width()*percent/100, b.Height()*percent/100)
 }
 
 // GeoPad expands the bound in all directions by the given amount of meters.
-// Only applies if the data is Lng/Lat degrees.
+// Only applies if the data is Lng/Lat degrees. Negative values shrink the bound,
+// down to a zero size bound at the center. Degrees are converted using EarthRadius,
+// consistent with Point.GeoDistanceFrom. Longitude uses the latitude of the edge
+// farthest from the equator, so every point within the distance of the bound is included.
 func (b *Bound) GeoPad(meters float64) *Bound {
-	dy := meters / 111131.75
+	dy := rad2deg(meters / EarthRadius)
 	dx := dy / math.Cos(deg2rad(b.ne.Lat()))
 	dx = math.Max(dx, dy/math.Cos(deg2rad(b.sw.Lat())))
 
-	b.sw.SetLng(b.sw.Lng() - dx)
-	b.sw.SetLat(b.sw.Lat() - dy)
+	return b.pad(dx, dy)
+}
+
+func (b *Bound) pad(dx, dy float64) *Bound {
+	b.sw[0] -= dx
+	b.ne[0] += dx
+	if b.sw[0] > b.ne[0] {
+		b.sw[0] = (b.sw[0] + b.ne[0]) / 2
+		b.ne[0] = b.sw[0]
+	}
 
-	b.ne.SetLng(b.ne.Lng() + dx)
-	b.ne.SetLat(b.ne.Lat() + dy)
+	b.sw[1] -= dy
+	b.ne[1] += dy
+	if b.sw[1] > b.ne[1] {
+		b.sw[1] = (b.sw[1] + b.ne[1]) / 2
+		b.ne[1] = b.sw[1]
+	}
 
 	return b
 }
@@ -279,6 +353,21 @@ func (b *Bound) Width() float64 {
 	return b.ne.X() - b.sw.X()
 }
 
+// Area returns the width times the height, in the units of the bound squared,
+// eg. degrees squared for lng/lat data. See GeoArea for square meters.
+func (b *Bound) Area() float64 {
+	return b.Width() * b.Height()
+}
+
+// GeoArea returns the area, in square meters, of the lng/lat (EPSG:4326) bound
+// on a spherical earth. Unlike Area the result is not in degrees, and bounds
+// of the same size in degrees get smaller towards the poles.
+func (b *Bound) GeoArea() float64 {
+	// the area of the band between the latitudes, scaled by the longitude fraction
+	return EarthRadius * EarthRadius * deg2rad(b.Width()) *
+		(math.Sin(deg2rad(b.ne[1])) - math.Sin(deg2rad(b.sw[1])))
+}
+
 // GeoHeight returns the approximate height in meters.
 // Only applies if the data is Lng/Lat degrees (EPSG:4326).
 func (b *Bound) GeoHeight() float64 {
@@ -383,6 +472,43 @@ func (b *Bound) ToLine() *Line {
 	return NewLine(b.sw, b.ne)
 }
 
+// ToPath returns the corners of the bound as a closed, counter clockwise, ring
+// starting at the southwest corner, ie. SW, SE, NE, NW, SW.
+func (b *Bound) ToPath() *Path {
+	return NewPathFromXYData([][2]float64{
+		{b.sw[0], b.sw[1]},
+		{b.ne[0], b.sw[1]},
+		{b.ne[0], b.ne[1]},
+		{b.sw[0], b.ne[1]},
+		{b.sw[0], b.sw[1]},
+	})
+}
+
+// ToGeoJSON creates a new geojson feature with a polygon geometry of the bound.
+// The ring is closed and counter clockwise, starting at the southwest corner.
+func (b *Bound) ToGeoJSON() *geojson.Feature {
+	return geojson.NewFeature(b.ToGeoJSONGeometry())
+}
+
+// ToGeoJSONGeometry creates a new geojson polygon geometry of the bound,
+// with the same ring as ToPath and ToWKT.
+func (b *Bound) ToGeoJSONGeometry() *geojson.Geometry {
+	return geojson.NewPolygonGeometry([][][]float64{{
+		{b.sw[0], b.sw[1]},
+		{b.ne[0], b.sw[1]},
+		{b.ne[0], b.ne[1]},
+		{b.sw[0], b.ne[1]},
+		{b.sw[0], b.sw[1]},
+	}})
+}
+
+// ToWKT returns the bound as a WKT polygon with a closed counter clockwise ring,
+// eg. POLYGON((0 0,1 0,1 1,0 1,0 0)). String uses a clockwise ring.
+func (b *Bound) ToWKT() string {
+	return fmt.Sprintf("POLYGON((%g %g,%g %g,%g %g,%g %g,%g %g))",
+		b.sw[0], b.sw[1], b.ne[0], b.sw[1], b.ne[0], b.ne[1], b.sw[0], b.ne[1], b.sw[0], b.sw[1])
+}
+
 // String returns the string respentation of the bound in WKT format.
 // POLYGON(west, south, west, north, east, north, east, south, west, south)
 func (b *Bound) String() string {
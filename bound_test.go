@@ -64,6 +64,166 @@ func TestGeoBoundAroundPoint(t *testing.T) {
 	}
 }
 
+func TestGeoBoundAroundPointEdgeCases(t *testing.T) {
+	// near the pole the longitude span widens
+	p := NewPoint(5, 89.9)
+	bound := NewGeoBoundAroundPoint(p, 1000)
+
+	if bound.West() >= bound.East() || bound.South() >= bound.North() {
+		t.Errorf("bound, should be valid near the pole, got %v", bound)
+	}
+
+	if !bound.Contains(p) {
+		t.Errorf("bound, should contain center near the pole")
+	}
+
+	if w := bound.East() - bound.West(); w < 10 {
+		t.Errorf("bound, longitude span should be wide near the pole, got %f", w)
+	}
+
+	// crosses the pole
+	bound = NewGeoBoundAroundPoint(p, 20000)
+	if bound.West() != -180 || bound.East() != 180 || bound.North() != 90 {
+		t.Errorf("bound, should span all longitudes up to the pole, got %v", bound)
+	}
+
+	if bound.South() >= p.Lat() || bound.South() < 89.7 {
+		t.Errorf("bound, south incorrect, got %v", bound.South())
+	}
+
+	bound = NewGeoBoundAroundPoint(NewPoint(5, -89.9), 20000)
+	if bound.West() != -180 || bound.East() != 180 || bound.South() != -90 {
+		t.Errorf("bound, should span all longitudes down to the south pole, got %v", bound)
+	}
+
+	// crosses the anti-meridian
+	for _, lng := range []float64{179.99, -179.99} {
+		p = NewPoint(lng, 10)
+		bound = NewGeoBoundAroundPoint(p, 10000)
+
+		if bound.West() != -180 || bound.East() != 180 {
+			t.Errorf("bound, should span all longitudes at the anti-meridian, got %v", bound)
+		}
+
+		if !bound.Contains(p) {
+			t.Errorf("bound, should contain center at the anti-meridian")
+		}
+	}
+}
+
+func TestNewBoundAroundPoint(t *testing.T) {
+	bound := NewBoundAroundPoint(NewPoint(1, 2), 3)
+	if !bound.Equals(NewBound(-2, 4, -1, 5)) {
+		t.Errorf("bound, around point incorrect, got %v", bound)
+	}
+
+	bound = NewBoundAroundPoint(NewPoint(1, 2), 0)
+	if !bound.Empty() || !bound.Center().Equals(NewPoint(1, 2)) {
+		t.Errorf("bound, around point with zero distance should be empty, got %v", bound)
+	}
+}
+
+func TestNewBoundFromPoints(t *testing.T) {
+	expected := NewBound(1, 3, 2, 4)
+
+	corners := [][2]*Point{
+		{NewPoint(1, 2), NewPoint(3, 4)},
+		{NewPoint(3, 4), NewPoint(1, 2)},
+		{NewPoint(1, 4), NewPoint(3, 2)},
+		{NewPoint(3, 2), NewPoint(1, 4)},
+	}
+
+	for _, c := range corners {
+		if b := NewBoundFromPoints(c[0], c[1]); !b.Equals(expected) {
+			t.Errorf("bound, from points %v %v incorrect, got %v", c[0], c[1], b)
+		}
+	}
+}
+
+func TestNewBoundAroundPointPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("bound, negative distance should panic")
+		}
+	}()
+
+	NewBoundAroundPoint(NewPoint(1, 2), -1)
+}
+
+func TestNewBoundFromQuadkey(t *testing.T) {
+	if b := NewBoundFromQuadkey(0, 0); math.Abs(b.West()+180) > epsilon || math.Abs(b.East()-180) > epsilon ||
+		math.Abs(b.North()-maxMercatorLatitude) > epsilon || math.Abs(b.South()+maxMercatorLatitude) > epsilon {
+		t.Errorf("bound, quadkey level 0 should be the world, got %v", b)
+	}
+
+	for _, level := range []int{1, 9, 15, 30} {
+		for _, city := range cities {
+			p := NewPoint(city[1], city[0])
+			key := p.Quadkey(level)
+
+			bound := NewBoundFromQuadkey(key, level)
+			if !bound.Contains(p) {
+				t.Errorf("bound, quadkey %d at level %d should contain %v, got %v", key, level, p, bound)
+			}
+
+			if c := bound.Center(); c.Quadkey(level) != key {
+				t.Errorf("bound, center of quadkey %d at level %d should have the same key, got %d", key, level, c.Quadkey(level))
+			}
+
+			if nw := NewPointFromQuadkey(key, level); nw.DistanceFrom(bound.NorthWest()) > epsilon {
+				t.Errorf("bound, quadkey point should be the north west corner, got %v, expected %v", nw, bound.NorthWest())
+			}
+		}
+	}
+
+	// same as the map tile
+	if b := NewBoundFromQuadkey(NewPoint(-87.65005229999997, 41.850033).Quadkey(15), 15); !b.Equals(NewBoundFromMapTile(8405, 12182, 15)) {
+		t.Errorf("bound, quadkey should match the map tile, got %v", b)
+	}
+}
+
+func TestNewBoundFromGeoHash(t *testing.T) {
+	cases := []struct {
+		hash     string
+		expected *Bound
+	}{
+		{"", NewBound(-180, 180, -90, 90)},
+		{"9", NewBound(-135, -90, 0, 45)},
+		{"9q", NewBound(-123.75, -112.5, 33.75, 39.375)},
+		{"s", NewBound(0, 45, 0, 45)},
+	}
+
+	for _, tc := range cases {
+		if b := NewBoundFromGeoHash(tc.hash); !b.Equals(tc.expected) {
+			t.Errorf("bound, geohash %q expected %v, got %v", tc.hash, tc.expected, b)
+		}
+	}
+
+	// the 32 cells of a hash tile it
+	parent := NewBoundFromGeoHash("9q8y")
+	area := 0.0
+	for _, r := range base32 {
+		cell := NewBoundFromGeoHash("9q8y" + string(r))
+		if !parent.Contains(cell.SouthWest()) || !parent.Contains(cell.NorthEast()) {
+			t.Errorf("bound, geohash cell %c should be within the parent", r)
+		}
+
+		area += cell.Area()
+	}
+
+	if math.Abs(area-parent.Area()) > 1e-12 {
+		t.Errorf("bound, geohash cells should cover the parent, got area %v of %v", area, parent.Area())
+	}
+
+	// the cell contains points that hash to it
+	for _, c := range citiesGeoHash {
+		hash := c[2].(string)
+		if !NewBoundFromGeoHash(hash).Contains(NewPoint(c[1].(float64), c[0].(float64))) {
+			t.Errorf("bound, geohash %s should contain its point", hash)
+		}
+	}
+}
+
 func TestNewBoundFromMapTile(t *testing.T) {
 	bound := NewBoundFromMapTile(7, 8, 9)
 
@@ -105,6 +265,19 @@ func TestBoundExtend(t *testing.T) {
 	if b := bound.Clone().Extend(NewPoint(6, -1)); !b.Equals(answer) {
 		t.Errorf("bound, extend expected %v, got %v", answer, b)
 	}
+
+	// chained from a zero bound
+	b := NewBound(0, 0, 0, 0).Extend(NewPoint(-1, 2)).Extend(NewPoint(3, -4))
+	if !b.Equals(NewBound(-1, 3, -4, 2)) {
+		t.Errorf("bound, chained extend incorrect, got %v", b)
+	}
+
+	// clone keeps the original
+	original := NewBound(0, 1, 0, 1)
+	original.Clone().Extend(NewPoint(5, 5))
+	if !original.Equals(NewBound(0, 1, 0, 1)) {
+		t.Errorf("bound, extend of clone should not change original, got %v", original)
+	}
 }
 
 func TestBoundUnion(t *testing.T) {
@@ -119,6 +292,51 @@ func TestBoundUnion(t *testing.T) {
 	if b := b2.Clone().Union(b1); !b.Equals(expected) {
 		t.Errorf("bound, expected %v, got %v", expected, b)
 	}
+
+	bounds := []*Bound{
+		NewBound(0, 1, 0, 1),
+		NewBound(-3, -2, 5, 6),
+		NewBound(0.5, 4, -1, 0.5),
+		NewBound(2, 2, 2, 2),
+	}
+
+	for _, a := range bounds {
+		for _, b := range bounds {
+			// commutative
+			if u1, u2 := a.Clone().Union(b), b.Clone().Union(a); !u1.Equals(u2) {
+				t.Errorf("bound, union should be commutative, %v != %v", u1, u2)
+			}
+
+			// same as the bound of all the corners
+			u := a.Clone().Union(b)
+			corners := NewBoundFromPoints(a.SouthWest(), a.NorthEast()).
+				Extend(a.NorthWest()).Extend(a.SouthEast()).
+				Extend(b.SouthWest()).Extend(b.NorthEast()).
+				Extend(b.NorthWest()).Extend(b.SouthEast())
+
+			if !u.Equals(corners) {
+				t.Errorf("bound, union expected %v, got %v", corners, u)
+			}
+
+			if !u.ContainsBound(a) || !u.ContainsBound(b) {
+				t.Errorf("bound, union should contain both bounds")
+			}
+
+			for _, c := range bounds {
+				// associative
+				u1 := a.Clone().Union(b).Union(c)
+				u2 := a.Clone().Union(b.Clone().Union(c))
+				if !u1.Equals(u2) {
+					t.Errorf("bound, union should be associative, %v != %v", u1, u2)
+				}
+			}
+		}
+	}
+
+	// with itself
+	if b := b1.Clone().Union(b1); !b.Equals(b1) {
+		t.Errorf("bound, union with itself should not change, got %v", b)
+	}
 }
 
 func TestBoundContains(t *testing.T) {
@@ -210,6 +428,209 @@ func TestBoundIntersects(t *testing.T) {
 	}
 }
 
+func TestBoundIntersection(t *testing.T) {
+	bound := NewBound(0, 2, 0, 2)
+
+	cases := []struct {
+		name     string
+		other    *Bound
+		expected *Bound
+	}{
+		{"overlapping", NewBound(1, 3, 1, 3), NewBound(1, 2, 1, 2)},
+		{"inside", NewBound(0.5, 1, 0.5, 1), NewBound(0.5, 1, 0.5, 1)},
+		{"equal", NewBound(0, 2, 0, 2), NewBound(0, 2, 0, 2)},
+		{"shared edge", NewBound(2, 3, 0, 2), NewBound(2, 2, 0, 2)},
+		{"shared corner", NewBound(2, 3, 2, 3), NewBound(2, 2, 2, 2)},
+		{"disjoint", NewBound(3, 4, 0, 2), nil},
+	}
+
+	for _, tc := range cases {
+		b, ok := bound.Intersection(tc.other)
+		if ok != (tc.expected != nil) {
+			t.Errorf("bound, intersection %s: expected %v, got %v", tc.name, tc.expected != nil, ok)
+			continue
+		}
+
+		if ok && !b.Equals(tc.expected) {
+			t.Errorf("bound, intersection %s: expected %v, got %v", tc.name, tc.expected, b)
+		}
+
+		if ok != bound.Intersects(tc.other) {
+			t.Errorf("bound, intersection %s: should match intersects", tc.name)
+		}
+
+		// symmetric
+		if b2, ok2 := tc.other.Intersection(bound); ok2 != ok || (ok && !b2.Equals(b)) {
+			t.Errorf("bound, intersection %s: should be symmetric, got %v", tc.name, b2)
+		}
+	}
+
+	if _, ok := bound.Intersection(NewBound(1, 3, 1, 3)); !ok || !bound.Equals(NewBound(0, 2, 0, 2)) {
+		t.Errorf("bound, intersection should not modify the bound, got %v", bound)
+	}
+
+	// with itself is an equal, but new, bound
+	b, ok := bound.Intersection(bound)
+	if !ok || !b.Equals(bound) || b == bound {
+		t.Errorf("bound, intersection with itself should be a copy, got %v", b)
+	}
+
+	b.Extend(NewPoint(5, 5))
+	if !bound.Equals(NewBound(0, 2, 0, 2)) {
+		t.Errorf("bound, intersection should not share points with the bound, got %v", bound)
+	}
+
+	// contained by both, for clipping
+	for _, other := range []*Bound{NewBound(1, 3, -1, 1), NewBound(-1, 0.5, 0.5, 5), NewBound(0.2, 0.4, 0.2, 0.4)} {
+		b, ok := bound.Intersection(other)
+		if !ok || !bound.ContainsBound(b) || !other.ContainsBound(b) {
+			t.Errorf("bound, intersection should be within both bounds, got %v", b)
+		}
+	}
+}
+
+func TestBoundContainsBoundary(t *testing.T) {
+	bound := NewBound(-1, 2, -3, 4)
+
+	cases := []struct {
+		name     string
+		point    *Point
+		expected bool
+	}{
+		{"west edge", NewPoint(-1, 0), true},
+		{"east edge", NewPoint(2, 0), true},
+		{"south edge", NewPoint(0, -3), true},
+		{"north edge", NewPoint(0, 4), true},
+		{"south west corner", NewPoint(-1, -3), true},
+		{"north east corner", NewPoint(2, 4), true},
+		{"north west corner", NewPoint(-1, 4), true},
+		{"south east corner", NewPoint(2, -3), true},
+		{"just west", NewPoint(-1-epsilon, 0), false},
+		{"just east", NewPoint(2+epsilon, 0), false},
+		{"just south", NewPoint(0, -3-epsilon), false},
+		{"just north", NewPoint(0, 4+epsilon), false},
+	}
+
+	for _, tc := range cases {
+		if v := bound.Contains(tc.point); v != tc.expected {
+			t.Errorf("bound, contains %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		if v := bound.IntersectsPoint(tc.point); v != tc.expected {
+			t.Errorf("bound, intersects point %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		// same as a zero size bound
+		if v := bound.Intersects(NewBoundFromPoints(tc.point, tc.point)); v != tc.expected {
+			t.Errorf("bound, intersects zero size bound %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+	}
+}
+
+func TestBoundContainsBound(t *testing.T) {
+	bound := NewBound(0, 2, 0, 2)
+
+	cases := []struct {
+		name     string
+		other    *Bound
+		expected bool
+	}{
+		{"inside", NewBound(0.5, 1, 0.5, 1), true},
+		{"equal", NewBound(0, 2, 0, 2), true},
+		{"shared edge inside", NewBound(0, 1, 0, 2), true},
+		{"shared corner inside", NewBound(1, 2, 1, 2), true},
+		{"empty on edge", NewBound(2, 2, 1, 1), true},
+		{"overlapping", NewBound(1, 3, 1, 3), false},
+		{"shared edge outside", NewBound(2, 3, 0, 2), false},
+		{"larger", NewBound(-1, 3, -1, 3), false},
+	}
+
+	for _, tc := range cases {
+		if v := bound.ContainsBound(tc.other); v != tc.expected {
+			t.Errorf("bound, contains bound %s: expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		// consistent with Contains of the corners, and distinct from Intersects
+		corners := bound.Contains(tc.other.SouthWest()) && bound.Contains(tc.other.NorthEast())
+		if corners != tc.expected {
+			t.Errorf("bound, contains bound %s should match contains of corners", tc.name)
+		}
+
+		if !bound.Intersects(tc.other) {
+			t.Errorf("bound, contains bound %s cases should all intersect", tc.name)
+		}
+	}
+
+	if !NewBound(-1, 3, -1, 3).ContainsBound(bound) {
+		t.Errorf("bound, larger bound should contain bound")
+	}
+}
+
+func TestBoundIntersectsDegenerate(t *testing.T) {
+	bound := NewBound(0, 2, 0, 2)
+
+	cases := []struct {
+		name     string
+		b1, b2   *Bound
+		expected bool
+	}{
+		{"point inside", bound, NewBound(1, 1, 1, 1), true},
+		{"point on edge", bound, NewBound(2, 2, 1, 1), true},
+		{"point on corner", bound, NewBound(0, 0, 0, 0), true},
+		{"point outside", bound, NewBound(3, 3, 1, 1), false},
+		{"same point", NewBound(1, 1, 1, 1), NewBound(1, 1, 1, 1), true},
+		{"different points", NewBound(1, 1, 1, 1), NewBound(1+epsilon, 1+epsilon, 1, 1), false},
+		{"vertical line across", bound, NewBound(1, 1, -1, 3), true},
+		{"horizontal line on edge", bound, NewBound(-1, 3, 2, 2), true},
+		{"line outside", bound, NewBound(-1, 3, 2+epsilon, 2+epsilon), false},
+		{"crossing lines", NewBound(1, 1, 0, 2), NewBound(0, 2, 1, 1), true},
+		{"parallel lines", NewBound(1, 1, 0, 2), NewBound(1.5, 1.5, 0, 2), false},
+		{"collinear lines", NewBound(0, 1, 1, 1), NewBound(1, 2, 1, 1), true},
+		{"point on line", NewBound(0, 2, 1, 1), NewBound(1, 1, 1, 1), true},
+	}
+
+	for _, tc := range cases {
+		if v := tc.b1.Intersects(tc.b2); v != tc.expected {
+			t.Errorf("bound, intersects %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		if v := tc.b2.Intersects(tc.b1); v != tc.expected {
+			t.Errorf("bound, intersects %s should be symmetric", tc.name)
+		}
+	}
+}
+
+func TestBoundTouching(t *testing.T) {
+	bound := NewBound(0, 2, 0, 2)
+
+	// shared edge and corner are inclusive
+	for _, other := range []*Bound{NewBound(2, 3, 0, 2), NewBound(2, 3, 2, 3), NewBound(-1, 0, -1, 0)} {
+		if !bound.Intersects(other) || !other.Intersects(bound) {
+			t.Errorf("bound, touching bounds should intersect, %v %v", bound, other)
+		}
+	}
+
+	for _, p := range []*Point{NewPoint(2, 1), NewPoint(2, 2), NewPoint(0, 0)} {
+		if !bound.Contains(p) {
+			t.Errorf("bound, point on boundary should be contained, %v", p)
+		}
+	}
+}
+
+func TestBoundExtendFromPath(t *testing.T) {
+	path := NewPath().Push(NewPoint(3, -1)).Push(NewPoint(-2, 4))
+
+	b := NewBound(0, 1, 0, 1).ExtendFromPath(path)
+	if !b.Equals(NewBound(-2, 3, -1, 4)) {
+		t.Errorf("bound, extend from path incorrect, got %v", b)
+	}
+
+	b = NewBound(0, 1, 0, 1).ExtendFromPath(NewPath())
+	if !b.Equals(NewBound(0, 1, 0, 1)) {
+		t.Errorf("bound, extend from empty path should not change bound, got %v", b)
+	}
+}
+
 func TestBoundCenter(t *testing.T) {
 	var p *Point
 	var b *Bound
@@ -225,6 +646,22 @@ func TestBoundCenter(t *testing.T) {
 	if c := b.Center(); !c.Equals(p) {
 		t.Errorf("bound, center expected %v, got %v", p, c)
 	}
+
+	b = NewBound(-122.5, -122.1, 37.5, 37.9)
+	if c := b.Center(); !b.Contains(c) {
+		t.Errorf("bound, center should be within the bound, got %v", c)
+	}
+
+	// consistent with the geohash point
+	for _, hash := range []string{"9", "9q8y", "9q8yyk8yuv", "zzzzzz", "000000"} {
+		if c, p := NewBoundFromGeoHash(hash).Center(), NewPointFromGeoHash(hash); !c.Equals(p) {
+			t.Errorf("bound, center of %s expected %v, got %v", hash, p, c)
+		}
+
+		if h := NewBoundFromGeoHash(hash).Center().GeoHash(len(hash)); h != hash {
+			t.Errorf("bound, center of %s should have the same geohash, got %s", hash, h)
+		}
+	}
 }
 
 func TestBoundPad(t *testing.T) {
@@ -243,6 +680,78 @@ func TestBoundPad(t *testing.T) {
 	}
 }
 
+func TestBoundPadShrink(t *testing.T) {
+	// shrinks to the center instead of inverting
+	bound := NewBound(0, 1, 2, 6).Pad(-1)
+	if !bound.Equals(NewBound(0.5, 0.5, 3, 5)) {
+		t.Errorf("bound, pad should not invert, got %v", bound)
+	}
+
+	bound = NewBound(0, 1, 2, 6).Pad(-10)
+	if !bound.Equals(NewBound(0.5, 0.5, 4, 4)) {
+		t.Errorf("bound, pad should shrink to the center, got %v", bound)
+	}
+
+	bound = NewBound(-122.5, -122.4, 37.7, 37.8).GeoPad(-100000)
+	if !bound.Empty() || !bound.Center().Equals(NewPoint(-122.45, 37.75)) {
+		t.Errorf("bound, geo pad should shrink to the center, got %v", bound)
+	}
+}
+
+func TestBoundPadPercent(t *testing.T) {
+	bound := NewBound(0, 100, 0, 50).PadPercent(10)
+	if !bound.Equals(NewBound(-10, 110, -5, 55)) {
+		t.Errorf("bound, pad percent incorrect, got %v", bound)
+	}
+
+	bound = NewBound(0, 100, 0, 50).PadPercent(-25)
+	if !bound.Equals(NewBound(25, 75, 12.5, 37.5)) {
+		t.Errorf("bound, pad percent shrink incorrect, got %v", bound)
+	}
+
+	bound = NewBound(0, 100, 0, 50).PadPercent(-80)
+	if !bound.Equals(NewBound(50, 50, 25, 25)) {
+		t.Errorf("bound, pad percent should shrink to the center, got %v", bound)
+	}
+
+	bound = NewBound(1, 1, 2, 2).PadPercent(10)
+	if !bound.Equals(NewBound(1, 1, 2, 2)) {
+		t.Errorf("bound, pad percent of empty bound should be empty, got %v", bound)
+	}
+}
+
+func TestBoundGeoPadLatitudeScale(t *testing.T) {
+	// at 60 degrees a degree of longitude is half as long
+	bound := NewBound(10, 10, 60, 60).GeoPad(1000)
+
+	if ratio := bound.Width() / bound.Height(); math.Abs(ratio-2) > 0.01 {
+		t.Errorf("bound, geo pad at 60N should expand lng twice as much as lat, got %f", ratio)
+	}
+}
+
+func TestBoundGeoPadDistance(t *testing.T) {
+	bound := NewBound(-122.5, -122.1, 37.5, 37.9)
+	padded := bound.Clone().GeoPad(100)
+
+	// the new edges are the distance away, using the same earth radius
+	if d := NewPoint(-122.3, 37.9).GeoDistanceFrom(NewPoint(-122.3, padded.North()), true); math.Abs(d-100) > 1e-6 {
+		t.Errorf("bound, geo pad north expected 100 meters, got %v", d)
+	}
+
+	if d := NewPoint(-122.3, 37.5).GeoDistanceFrom(NewPoint(-122.3, padded.South()), true); math.Abs(d-100) > 1e-6 {
+		t.Errorf("bound, geo pad south expected 100 meters, got %v", d)
+	}
+
+	// at least the distance along the northern edge, more along the southern edge
+	if d := NewPoint(-122.1, 37.9).GeoDistanceFrom(NewPoint(padded.East(), 37.9), true); math.Abs(d-100) > 1e-3 {
+		t.Errorf("bound, geo pad east expected 100 meters at the north edge, got %v", d)
+	}
+
+	if d := NewPoint(-122.5, 37.5).GeoDistanceFrom(NewPoint(padded.West(), 37.5), true); d < 100 {
+		t.Errorf("bound, geo pad west should be at least 100 meters at the south edge, got %v", d)
+	}
+}
+
 func TestBoundGeoPad(t *testing.T) {
 	tests := []*Bound{
 		NewBoundFromPoints(NewPoint(-122.559, 37.887), NewPoint(-122.521, 37.911)),
@@ -263,6 +772,51 @@ func TestBoundGeoPad(t *testing.T) {
 	}
 }
 
+func TestBoundArea(t *testing.T) {
+	if a := NewBound(1, 3, 2, 5).Area(); a != 6 {
+		t.Errorf("bound, area expected 6, got %v", a)
+	}
+
+	if a := NewBound(1, 1, 2, 5).Area(); a != 0 {
+		t.Errorf("bound, area expected 0, got %v", a)
+	}
+}
+
+func TestBoundGeoArea(t *testing.T) {
+	world := NewBound(-180, 180, -90, 90)
+	if a, expected := world.GeoArea(), 4*math.Pi*EarthRadius*EarthRadius; math.Abs(a-expected) > 1 {
+		t.Errorf("bound, geo area of the world expected %v, got %v", expected, a)
+	}
+
+	// about 12,391 square kilometers at the equator
+	if a := NewBound(0, 1, 0, 1).GeoArea(); math.Abs(a-1.2391e10) > 1e7 {
+		t.Errorf("bound, geo area of one degree expected 1.2391e10, got %v", a)
+	}
+
+	// smaller towards the poles
+	if a1, a2 := NewBound(0, 1, 0, 1).GeoArea(), NewBound(0, 1, 60, 61).GeoArea(); a2 >= a1/1.9 {
+		t.Errorf("bound, geo area at 60N should be about half, got %v and %v", a1, a2)
+	}
+
+	// tiles at the same zoom split the area
+	total := 0.0
+	for _, tile := range world.Tiles(2) {
+		total += tile.Bound().GeoArea()
+	}
+
+	mercator := NewBound(-180, 180, -maxMercatorLatitude, maxMercatorLatitude)
+	if math.Abs(total-mercator.GeoArea()) > 1 {
+		t.Errorf("bound, geo area of tiles expected %v, got %v", mercator.GeoArea(), total)
+	}
+
+	// same as the area of the corners as a path
+	b := NewBound(-122.5, -122.1, 37.5, 37.9)
+	path := NewPath().Push(b.SouthWest()).Push(b.SouthEast()).Push(b.NorthEast()).Push(b.NorthWest())
+	if a, expected := b.GeoArea(), path.GeoArea(); math.Abs(a-expected) > 1e-6*expected {
+		t.Errorf("bound, geo area expected %v, got %v", expected, a)
+	}
+}
+
 func TestBoundAccessors(t *testing.T) {
 	bound := NewBound(1, 2, 3, 4)
 
@@ -390,6 +944,99 @@ func TestBoundEmpty(t *testing.T) {
 	}
 }
 
+func TestBoundToPath(t *testing.T) {
+	bound := NewBound(1, 4, 2, 4)
+	path := bound.ToPath()
+
+	expected := NewPathFromXYData([][2]float64{{1, 2}, {4, 2}, {4, 4}, {1, 4}, {1, 2}})
+	if !path.Equals(expected) {
+		t.Errorf("bound, to path expected %v, got %v", expected, path)
+	}
+
+	if !path.IsRing() || path.IsClockwise() {
+		t.Errorf("bound, to path should be a counter clockwise ring")
+	}
+
+	if l := path.Distance(); l != 10 {
+		t.Errorf("bound, to path perimeter expected 10, got %v", l)
+	}
+
+	if a := path.Area(); a != bound.Area() {
+		t.Errorf("bound, to path area expected %v, got %v", bound.Area(), a)
+	}
+
+	if b := path.Bound(); !b.Equals(bound) {
+		t.Errorf("bound, to path should have the same bound, got %v", b)
+	}
+
+	// the same ring as geojson
+	if ring := NewPathFromXYSlice(bound.ToGeoJSON().Geometry.Polygon[0]); !ring.Equals(path) {
+		t.Errorf("bound, to path should match geojson, got %v", ring)
+	}
+}
+
+func TestBoundToGeoJSON(t *testing.T) {
+	for _, bound := range []*Bound{NewBound(1, 3, 2, 5), NewBound(1, 1, 2, 2)} {
+		f := bound.ToGeoJSON()
+		if !f.Geometry.IsPolygon() || len(f.Geometry.Polygon) != 1 {
+			t.Fatalf("bound, geojson should be a polygon with one ring, got %v", f.Geometry)
+		}
+
+		ring := NewPathFromXYSlice(f.Geometry.Polygon[0])
+		if ring.Length() != 5 || !ring.IsRing() {
+			t.Errorf("bound, geojson ring should be closed with 5 points, got %v", ring)
+		}
+
+		if !ring.First().Equals(bound.SouthWest()) {
+			t.Errorf("bound, geojson ring should start at the southwest corner, got %v", ring.First())
+		}
+
+		if !bound.Empty() && ring.IsClockwise() {
+			t.Errorf("bound, geojson ring should be counter clockwise, got %v", ring)
+		}
+
+		if !ring.Bound().Equals(bound) {
+			t.Errorf("bound, geojson ring should have the same bound, got %v", ring.Bound())
+		}
+	}
+}
+
+func TestBoundToGeoJSONGeometry(t *testing.T) {
+	bound := NewBound(1, 3, 2, 5)
+
+	g := bound.ToGeoJSONGeometry()
+	if !g.IsPolygon() || len(g.Polygon) != 1 {
+		t.Fatalf("bound, geojson geometry should be a polygon with one ring, got %v", g)
+	}
+
+	if ring := NewPathFromXYSlice(g.Polygon[0]); !ring.Equals(bound.ToPath()) {
+		t.Errorf("bound, geojson geometry ring should match the path, got %v", ring)
+	}
+}
+
+func TestBoundToWKT(t *testing.T) {
+	bound := NewBound(1, 3, 2, 5)
+	if s := bound.ToWKT(); s != "POLYGON((1 2,3 2,3 5,1 5,1 2))" {
+		t.Errorf("bound, wkt incorrect, got %s", s)
+	}
+
+	bound = NewBound(1.5, 1.5, -2, -2)
+	if s := bound.ToWKT(); s != "POLYGON((1.5 -2,1.5 -2,1.5 -2,1.5 -2,1.5 -2))" {
+		t.Errorf("bound, wkt of empty bound incorrect, got %s", s)
+	}
+
+	// decodes back to the same bound
+	bound = NewBound(-122.5, -122.4, 37.7, 37.8)
+	g, err := NewGeometryFromWKT(bound.ToWKT())
+	if err != nil {
+		t.Fatalf("bound, wkt decode error: %v", err)
+	}
+
+	if b := g.Bound(); !b.Equals(bound) {
+		t.Errorf("bound, wkt should decode to the same bound, got %v", b)
+	}
+}
+
 func TestBoundString(t *testing.T) {
 	bound := NewBound(1, 2, 3, 4)
 
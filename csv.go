@@ -0,0 +1,181 @@
+package geo
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions are the options used when reading lat/lng columns from CSV data.
+type CSVOptions struct {
+	// LatColumn and LngColumn are the zero based indexes of the columns.
+	LatColumn int
+	LngColumn int
+
+	// HasHeader skips the first row.
+	HasHeader bool
+
+	// DetectColumns finds the columns using the header names,
+	// lat or latitude and lng, lon, long or longitude. Implies HasHeader.
+	DetectColumns bool
+
+	// Validate returns an error for values outside the lng/lat range.
+	Validate bool
+}
+
+// NewPathFromCSV creates a path from the latitude and longitude columns of CSV data.
+// Blank lines are skipped. Errors include the line number of the bad value.
+func NewPathFromCSV(r io.Reader, latCol, lngCol int, hasHeader bool) (*Path, error) {
+	return NewPathFromCSVWithOptions(r, CSVOptions{
+		LatColumn: latCol,
+		LngColumn: lngCol,
+		HasHeader: hasHeader,
+	})
+}
+
+// NewPathFromCSVWithOptions creates a path from the latitude and longitude
+// columns of CSV data using the given options.
+func NewPathFromCSVWithOptions(r io.Reader, opts CSVOptions) (*Path, error) {
+	ps, err := NewPointSetFromCSV(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Path{*ps}, nil
+}
+
+// NewPointSetFromCSV creates a point set from the latitude and longitude
+// columns of CSV data using the given options. Blank lines are skipped.
+// Errors include the line number of the bad value.
+func NewPointSetFromCSV(r io.Reader, opts CSVOptions) (*PointSet, error) {
+	lines := &csvLineReader{r: bufio.NewReader(r), start: true}
+	reader := csv.NewReader(lines)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	latCol, lngCol := opts.LatColumn, opts.LngColumn
+	if opts.HasHeader || opts.DetectColumns {
+		header, err := reader.Read()
+		if err == io.EOF {
+			return &PointSet{}, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.DetectColumns {
+			latCol, lngCol = -1, -1
+			for i, name := range header {
+				switch strings.ToLower(strings.TrimSpace(name)) {
+				case "lat", "latitude":
+					latCol = i
+				case "lng", "lon", "long", "longitude":
+					lngCol = i
+				}
+			}
+
+			if latCol == -1 || lngCol == -1 {
+				return nil, fmt.Errorf("geo: csv header must have lat and lng columns, got %v", header)
+			}
+		}
+	}
+
+	ps := PointSet{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line := lines.line
+
+		lat, err := csvFloat(record, latCol, line)
+		if err != nil {
+			return nil, err
+		}
+
+		lng, err := csvFloat(record, lngCol, line)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.Validate && (lat < -90 || lat > 90 || lng < -180 || lng > 180) {
+			return nil, fmt.Errorf("geo: csv line %d: lat/lng out of range, got %v, %v", line, lat, lng)
+		}
+
+		ps = append(ps, Point{lng, lat})
+	}
+
+	return &ps, nil
+}
+
+// csvLineReader returns at most one line per Read so the csv reader never
+// reads ahead, line is the number of lines started so far.
+type csvLineReader struct {
+	r     *bufio.Reader
+	line  int
+	start bool
+}
+
+func (l *csvLineReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		b, err := l.r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+
+		if l.start {
+			l.line++
+			l.start = false
+		}
+
+		p[n] = b
+		n++
+
+		if b == '\n' {
+			l.start = true
+			break
+		}
+	}
+
+	return n, nil
+}
+
+func csvFloat(record []string, column, line int) (float64, error) {
+	if column < 0 || column >= len(record) {
+		return 0, fmt.Errorf("geo: csv line %d: missing column %d", line, column)
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(record[column]), 64)
+	if err != nil {
+		return 0, fmt.Errorf("geo: csv line %d: invalid number %q in column %d", line, record[column], column)
+	}
+
+	return f, nil
+}
+
+// ToCSV writes the points as CSV data with a lat,lng header
+// followed by a row for every point.
+func (ps PointSet) ToCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"lat", "lng"})
+
+	for _, p := range ps {
+		writer.Write([]string{
+			strconv.FormatFloat(p[1], 'f', -1, 64),
+			strconv.FormatFloat(p[0], 'f', -1, 64),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,125 @@
+package geo
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewPathFromCSV(t *testing.T) {
+	data := "37.77493,-122.41942\n\n37.77551,-122.41866\n"
+
+	p, err := NewPathFromCSV(strings.NewReader(data), 0, 1, false)
+	if err != nil {
+		t.Fatalf("csv, parse error: %v", err)
+	}
+
+	expected := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551))
+
+	if !p.Equals(expected) {
+		t.Errorf("csv, path incorrect, got %v", p)
+	}
+
+	// swapped column order with header
+	data = "lng,lat\n-122.41942,37.77493\n-122.41866,37.77551\n"
+	p, err = NewPathFromCSV(strings.NewReader(data), 1, 0, true)
+	if err != nil || !p.Equals(expected) {
+		t.Errorf("csv, swapped columns incorrect, got %v %v", p, err)
+	}
+
+	p, err = NewPathFromCSV(strings.NewReader(""), 0, 1, true)
+	if err != nil || p.Length() != 0 {
+		t.Errorf("csv, empty data should be empty path, got %v %v", p, err)
+	}
+}
+
+func TestNewPathFromCSVErrors(t *testing.T) {
+	cases := []struct {
+		data  string
+		error string
+	}{
+		{"1,2\n\n3,abc\n", "csv line 3: invalid number \"abc\" in column 1"},
+		{"1,2\n3\n", "csv line 2: missing column 1"},
+		{"1,2\r\n\r\n3,abc\r\n", "csv line 3: invalid number \"abc\" in column 1"},
+		{"\"1\n\",2\n3,abc\n", "csv line 3: invalid number \"abc\" in column 1"},
+		{"1,\"2\n", "parse error"},
+	}
+
+	for _, tc := range cases {
+		_, err := NewPathFromCSV(strings.NewReader(tc.data), 0, 1, false)
+		if err == nil || !strings.Contains(err.Error(), tc.error) {
+			t.Errorf("csv, expected error containing %q, got %v", tc.error, err)
+		}
+	}
+}
+
+func TestNewPointSetFromCSVFile(t *testing.T) {
+	f, err := os.Open("testdata/points.csv")
+	if err != nil {
+		t.Fatalf("unable to open fixture: %v", err)
+	}
+	defer f.Close()
+
+	ps, err := NewPointSetFromCSV(f, CSVOptions{DetectColumns: true})
+	if err != nil {
+		t.Fatalf("csv, parse error: %v", err)
+	}
+
+	if l := ps.Length(); l != 4 {
+		t.Fatalf("csv, expected 4 points, got %d", l)
+	}
+
+	if p := ps.GetAt(0); !p.Equals(NewPoint(-122.41942, 37.77493)) {
+		t.Errorf("csv, first point incorrect, got %v", p)
+	}
+
+	// same file with validation
+	f.Seek(0, 0)
+	_, err = NewPointSetFromCSV(f, CSVOptions{DetectColumns: true, Validate: true})
+	if err == nil || !strings.Contains(err.Error(), "csv line 7: lat/lng out of range") {
+		t.Errorf("csv, expected out of range error on line 7, got %v", err)
+	}
+
+	// explicit columns
+	f.Seek(0, 0)
+	ps, err = NewPointSetFromCSV(f, CSVOptions{LatColumn: 2, LngColumn: 1, HasHeader: true})
+	if err != nil || ps.Length() != 4 {
+		t.Errorf("csv, explicit columns incorrect, got %v %v", ps, err)
+	}
+}
+
+func TestNewPointSetFromCSVDetectColumns(t *testing.T) {
+	_, err := NewPointSetFromCSV(strings.NewReader("x,y\n1,2\n"), CSVOptions{DetectColumns: true})
+	if err == nil {
+		t.Error("csv, header without lat/lng columns should error")
+	}
+
+	ps, err := NewPointSetFromCSV(strings.NewReader(" LAT , Lon\n1,2\n"), CSVOptions{DetectColumns: true})
+	if err != nil || ps.Length() != 1 || !ps.GetAt(0).Equals(NewPoint(2, 1)) {
+		t.Errorf("csv, detected columns incorrect, got %v %v", ps, err)
+	}
+}
+
+func TestPointSetToCSV(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(0.0000001, 1))
+
+	buf := &bytes.Buffer{}
+	if err := p.ToCSV(buf); err != nil {
+		t.Fatalf("csv, write error: %v", err)
+	}
+
+	expected := "lat,lng\n37.77493,-122.41942\n1,0.0000001\n"
+	if buf.String() != expected {
+		t.Errorf("csv, output incorrect, got %q", buf.String())
+	}
+
+	decoded, err := NewPathFromCSVWithOptions(buf, CSVOptions{DetectColumns: true, Validate: true})
+	if err != nil || !decoded.Equals(p) {
+		t.Errorf("csv, round trip incorrect, got %v %v", decoded, err)
+	}
+}
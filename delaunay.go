@@ -0,0 +1,223 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+)
+
+// A Triangle is three indexes into a point set, in counter clockwise order.
+type Triangle [3]int
+
+// delaunayTriangle is a triangle of the triangulation with its circumcircle.
+type delaunayTriangle struct {
+	Triangle
+	center  Point
+	radius2 float64
+}
+
+// Triangulate returns the Delaunay triangulation of the points, using the
+// Bowyer-Watson algorithm. Triangles are indexes into the point set, in counter
+// clockwise order. Collinear points, or fewer than 3, have no triangles.
+// Returns an error identifying the indexes of any duplicate points.
+// Assumes a planar projection.
+func (ps PointSet) Triangulate() ([]Triangle, error) {
+	triangles, err := ps.delaunay(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Triangle, 0, len(triangles))
+	for _, t := range triangles {
+		if t.Triangle[0] < len(ps) && t.Triangle[1] < len(ps) && t.Triangle[2] < len(ps) {
+			result = append(result, t.Triangle)
+		}
+	}
+
+	return result, nil
+}
+
+// Voronoi returns the Voronoi cells of the points clipped to the bound,
+// where cell i is the area closer to point i than any other point. The cells are
+// polygons with a closed counter clockwise ring. Points outside the bound may have
+// an empty cell. Returns an error identifying the indexes of any duplicate points.
+// Assumes a planar projection.
+func (ps PointSet) Voronoi(clip *Bound) ([]*Polygon, error) {
+	triangles, err := ps.delaunay(clip)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delaunay neighbors, edges to the super triangle are included
+	// so points on the convex hull, or collinear points, are not missed.
+	neighbors := make([]map[int]bool, len(ps))
+	for i := range neighbors {
+		neighbors[i] = make(map[int]bool)
+	}
+
+	for _, t := range triangles {
+		for i := 0; i < 3; i++ {
+			a, b := t.Triangle[i], t.Triangle[(i+1)%3]
+			if a < len(ps) && b < len(ps) {
+				neighbors[a][b] = true
+				neighbors[b][a] = true
+			}
+		}
+	}
+
+	cells := make([]*Polygon, len(ps))
+	for i := range ps {
+		cell := PointSet{
+			{clip.sw[0], clip.sw[1]},
+			{clip.ne[0], clip.sw[1]},
+			{clip.ne[0], clip.ne[1]},
+			{clip.sw[0], clip.ne[1]},
+		}
+
+		// the side of the perpendicular bisector closer to point i
+		for j := range neighbors[i] {
+			a := ps[j][0] - ps[i][0]
+			b := ps[j][1] - ps[i][1]
+			c := (ps[j][0]*ps[j][0] + ps[j][1]*ps[j][1] - ps[i][0]*ps[i][0] - ps[i][1]*ps[i][1]) / 2
+			cell = voronoiClip(cell, a, b, c)
+		}
+
+		path := NewPath().SetPoints(cell)
+		if len(cell) != 0 {
+			path.Close()
+		}
+
+		cells[i] = NewPolygon(path)
+	}
+
+	return cells, nil
+}
+
+// delaunay returns the triangulation of the points and a super triangle,
+// whose vertexes have the indexes len(ps), len(ps)+1 and len(ps)+2.
+// The super triangle is also sized to cover the extent bound, if not nil,
+// so edges to points on the hull are correct within it.
+func (ps PointSet) delaunay(extent *Bound) ([]delaunayTriangle, error) {
+	if err := delaunayDuplicates(ps); err != nil {
+		return nil, err
+	}
+
+	if len(ps) == 0 {
+		return nil, nil
+	}
+
+	// a super triangle much larger than the points and the extent
+	bound := ps.Bound()
+	if extent != nil {
+		bound = bound.Union(extent)
+	}
+
+	center := bound.Center()
+	size := 20 * math.Max(math.Max(bound.Width(), bound.Height()), 1)
+
+	points := make(PointSet, len(ps), len(ps)+3)
+	copy(points, ps)
+	points = append(points,
+		Point{center[0] - 2*size, center[1] - size},
+		Point{center[0] + 2*size, center[1] - size},
+		Point{center[0], center[1] + 2*size},
+	)
+
+	n := len(ps)
+	triangles := []delaunayTriangle{newDelaunayTriangle(points, n, n+1, n+2)}
+
+	for i := 0; i < n; i++ {
+		p := points[i]
+
+		// remove the triangles whose circumcircle contains the point,
+		// keeping the edges of the hole they leave.
+		var edges [][2]int
+		kept := triangles[:0]
+		for _, t := range triangles {
+			if t.center.SquaredDistanceFrom(&p) > t.radius2 {
+				kept = append(kept, t)
+				continue
+			}
+
+			for j := 0; j < 3; j++ {
+				edges = append(edges, [2]int{t.Triangle[j], t.Triangle[(j+1)%3]})
+			}
+		}
+		triangles = kept
+
+		// fill the hole with triangles to the point, shared edges are inside the hole
+		for j, e := range edges {
+			shared := false
+			for k, o := range edges {
+				if j != k && e[0] == o[1] && e[1] == o[0] {
+					shared = true
+					break
+				}
+			}
+
+			if !shared {
+				triangles = append(triangles, newDelaunayTriangle(points, e[0], e[1], i))
+			}
+		}
+	}
+
+	return triangles, nil
+}
+
+// newDelaunayTriangle creates a counter clockwise triangle of the points with its circumcircle.
+func newDelaunayTriangle(points PointSet, a, b, c int) delaunayTriangle {
+	if hullCross(&points[a], &points[b], &points[c]) < 0 {
+		b, c = c, b
+	}
+
+	ax, ay := points[a][0], points[a][1]
+	bx, by := points[b][0]-ax, points[b][1]-ay
+	cx, cy := points[c][0]-ax, points[c][1]-ay
+
+	d := 2 * (bx*cy - by*cx)
+	b2 := bx*bx + by*by
+	c2 := cx*cx + cy*cy
+
+	ux := (cy*b2 - by*c2) / d
+	uy := (bx*c2 - cx*b2) / d
+
+	return delaunayTriangle{
+		Triangle: Triangle{a, b, c},
+		center:   Point{ax + ux, ay + uy},
+		radius2:  ux*ux + uy*uy,
+	}
+}
+
+// delaunayDuplicates returns an error identifying the first duplicate points.
+func delaunayDuplicates(ps PointSet) error {
+	seen := make(map[Point]int, len(ps))
+	for i, p := range ps {
+		if j, ok := seen[p]; ok {
+			return fmt.Errorf("geo: duplicate points at indexes %d and %d", j, i)
+		}
+
+		seen[p] = i
+	}
+
+	return nil
+}
+
+// voronoiClip returns the part of the convex ring where a*x + b*y <= c.
+func voronoiClip(ring PointSet, a, b, c float64) PointSet {
+	result := make(PointSet, 0, len(ring)+1)
+	for i := range ring {
+		p, q := ring[i], ring[(i+1)%len(ring)]
+		dp := a*p[0] + b*p[1] - c
+		dq := a*q[0] + b*q[1] - c
+
+		if dp <= 0 {
+			result = append(result, p)
+		}
+
+		if (dp < 0 && dq > 0) || (dp > 0 && dq < 0) {
+			t := dp / (dp - dq)
+			result = append(result, Point{p[0] + t*(q[0]-p[0]), p[1] + t*(q[1]-p[1])})
+		}
+	}
+
+	return result
+}
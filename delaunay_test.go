@@ -0,0 +1,233 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestPointSetTriangulate(t *testing.T) {
+	ps := PointSet{{0, 0}, {2, 0}, {1, 2}, {1, 0.5}}
+
+	triangles, err := ps.Triangulate()
+	if err != nil {
+		t.Fatalf("delaunay, triangulate error: %v", err)
+	}
+
+	if len(triangles) != 3 {
+		t.Fatalf("delaunay, expected 3 triangles, got %v", triangles)
+	}
+
+	area := 0.0
+	for _, tri := range triangles {
+		a := hullCross(&ps[tri[0]], &ps[tri[1]], &ps[tri[2]]) / 2
+		if a <= 0 {
+			t.Errorf("delaunay, triangle should be counter clockwise, got %v", tri)
+		}
+		area += a
+	}
+
+	if area != 2 {
+		t.Errorf("delaunay, triangles should cover the hull, got area %v", area)
+	}
+
+	// degenerate
+	for _, ps := range []PointSet{{}, {{1, 1}}, {{0, 0}, {1, 1}}, {{0, 0}, {1, 1}, {2, 2}, {3, 3}}} {
+		triangles, err := ps.Triangulate()
+		if err != nil || len(triangles) != 0 {
+			t.Errorf("delaunay, %v should have no triangles, got %v %v", ps, triangles, err)
+		}
+	}
+}
+
+func TestPointSetTriangulateRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	ps := make(PointSet, 100)
+	for i := range ps {
+		ps[i] = Point{r.Float64(), r.Float64()}
+	}
+
+	triangles, err := ps.Triangulate()
+	if err != nil {
+		t.Fatalf("delaunay, triangulate error: %v", err)
+	}
+
+	// no point is inside the circumcircle of any triangle
+	for _, tri := range triangles {
+		c := newDelaunayTriangle(ps, tri[0], tri[1], tri[2])
+		for i := range ps {
+			if i == tri[0] || i == tri[1] || i == tri[2] {
+				continue
+			}
+
+			if d := c.center.SquaredDistanceFrom(&ps[i]); d < c.radius2*(1-1e-9) {
+				t.Errorf("delaunay, point %d inside the circumcircle of %v", i, tri)
+			}
+		}
+	}
+
+	area := 0.0
+	for _, tri := range triangles {
+		area += hullCross(&ps[tri[0]], &ps[tri[1]], &ps[tri[2]]) / 2
+	}
+
+	if h := ps.ConvexHull().Area(); math.Abs(area-h) > 1e-9 {
+		t.Errorf("delaunay, triangles should cover the convex hull, got %v, expected %v", area, h)
+	}
+}
+
+func TestPointSetVoronoi(t *testing.T) {
+	ps := PointSet{{1, 1}, {3, 1}, {3, 3}, {1, 3}}
+	clip := NewBound(0, 4, 0, 4)
+
+	cells, err := ps.Voronoi(clip)
+	if err != nil {
+		t.Fatalf("voronoi, error: %v", err)
+	}
+
+	if len(cells) != len(ps) {
+		t.Fatalf("voronoi, expected %d cells, got %d", len(ps), len(cells))
+	}
+
+	expected := []*Bound{
+		NewBound(0, 2, 0, 2),
+		NewBound(2, 4, 0, 2),
+		NewBound(2, 4, 2, 4),
+		NewBound(0, 2, 2, 4),
+	}
+
+	for i, cell := range cells {
+		if !cell.Exterior().IsRing() || cell.Exterior().IsClockwise() {
+			t.Errorf("voronoi, cell %d should be a counter clockwise ring, got %v", i, cell)
+		}
+
+		if a := cell.Area(); a != 4 {
+			t.Errorf("voronoi, cell %d area should be 4, got %v", i, a)
+		}
+
+		if b := cell.Bound(); !b.Equals(expected[i]) {
+			t.Errorf("voronoi, cell %d expected %v, got %v", i, expected[i], b)
+		}
+
+		if !cell.Contains(&ps[i]) {
+			t.Errorf("voronoi, cell %d should contain its seed", i)
+		}
+	}
+}
+
+func TestPointSetVoronoiRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	clip := NewBound(-1, 2, -1, 2)
+
+	ps := make(PointSet, 50)
+	for i := range ps {
+		ps[i] = Point{r.Float64(), r.Float64()}
+	}
+
+	cells, err := ps.Voronoi(clip)
+	if err != nil {
+		t.Fatalf("voronoi, error: %v", err)
+	}
+
+	area := 0.0
+	for i, cell := range cells {
+		if !cell.Contains(&ps[i]) {
+			t.Errorf("voronoi, cell %d should contain its seed", i)
+		}
+
+		area += cell.Area()
+	}
+
+	if math.Abs(area-9) > 1e-9 {
+		t.Errorf("voronoi, cells should cover the clip bound, got area %v", area)
+	}
+
+	// random points are in the cell of the nearest seed
+	for i := 0; i < 100; i++ {
+		p := Point{3*r.Float64() - 1, 3*r.Float64() - 1}
+		_, nearest := ps.DistanceFrom(&p)
+
+		if !cells[nearest].Exterior().ContainsWithBoundary(&p) {
+			t.Errorf("voronoi, %v should be in the cell of %v", p, ps[nearest])
+		}
+	}
+}
+
+func TestPointSetVoronoiLargeClip(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	clip := NewBound(-100, 100, -100, 100)
+
+	// clouds of 3 to 30 points much smaller than the clip bound
+	for k := 0; k < 280; k++ {
+		n := 3 + k%28
+		ps := make(PointSet, n)
+		for i := range ps {
+			ps[i] = Point{r.Float64(), r.Float64()}
+		}
+
+		cells, err := ps.Voronoi(clip)
+		if err != nil {
+			t.Fatalf("voronoi, error: %v", err)
+		}
+
+		area := 0.0
+		for _, cell := range cells {
+			area += cell.Area()
+		}
+
+		if math.Abs(area-40000) > 1e-6 {
+			t.Errorf("voronoi, %d cells should cover the clip bound, got area %v", n, area)
+		}
+
+		// far away points are in the cell of the nearest seed
+		for i := 0; i < 100; i++ {
+			p := Point{200*r.Float64() - 100, 200*r.Float64() - 100}
+			_, nearest := ps.DistanceFrom(&p)
+
+			if !cells[nearest].Exterior().ContainsWithBoundary(&p) {
+				t.Errorf("voronoi, %d points, %v should be in the cell of %v", n, p, ps[nearest])
+			}
+		}
+	}
+}
+
+func TestPointSetVoronoiDegenerate(t *testing.T) {
+	clip := NewBound(0, 4, 0, 4)
+
+	cells, err := PointSet{{1, 1}}.Voronoi(clip)
+	if err != nil || len(cells) != 1 || cells[0].Area() != 16 {
+		t.Errorf("voronoi, single point should be the clip bound, got %v %v", cells, err)
+	}
+
+	// collinear
+	cells, err = PointSet{{1, 2}, {2, 2}, {3, 2}}.Voronoi(clip)
+	if err != nil {
+		t.Fatalf("voronoi, error: %v", err)
+	}
+
+	for i, a := range []float64{6, 4, 6} {
+		if cells[i].Area() != a {
+			t.Errorf("voronoi, collinear cell %d area expected %v, got %v", i, a, cells[i].Area())
+		}
+	}
+
+	// seed outside the clip bound
+	cells, err = PointSet{{1, 1}, {10, 10}}.Voronoi(clip)
+	if err != nil || cells[1].Exterior().Length() != 0 {
+		t.Errorf("voronoi, seed outside should have an empty cell, got %v %v", cells, err)
+	}
+}
+
+func TestPointSetVoronoiDuplicates(t *testing.T) {
+	ps := PointSet{{0, 0}, {1, 1}, {2, 0}, {1, 1}}
+
+	if _, err := ps.Voronoi(NewBound(0, 2, 0, 2)); err == nil || !strings.Contains(err.Error(), "1 and 3") {
+		t.Errorf("voronoi, duplicate error should identify the indexes, got %v", err)
+	}
+
+	if _, err := ps.Triangulate(); err == nil || !strings.Contains(err.Error(), "1 and 3") {
+		t.Errorf("delaunay, duplicate error should identify the indexes, got %v", err)
+	}
+}
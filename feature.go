@@ -0,0 +1,139 @@
+package geo
+
+import "github.com/paulmach/go.geojson"
+
+// A Feature is a geometry with properties, such as a name or timestamp,
+// and an optional id. It is encoded as a GeoJSON Feature.
+type Feature struct {
+	ID         interface{}
+	Geometry   Geometry
+	Properties map[string]interface{}
+}
+
+// NewFeature creates a new feature of the geometry with empty properties.
+func NewFeature(geometry Geometry) *Feature {
+	return &Feature{
+		Geometry:   geometry,
+		Properties: make(map[string]interface{}),
+	}
+}
+
+// NewFeatureFromGeoJSON creates a feature from a geojson feature, see NewGeometryFromGeoJSON.
+// The id and properties are not copied. Returns ErrIncorrectGeometry if the geometry
+// is missing or not supported.
+func NewFeatureFromGeoJSON(f *geojson.Feature) (*Feature, error) {
+	geometry, err := NewGeometryFromGeoJSON(f.Geometry)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := f.Properties
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+
+	return &Feature{
+		ID:         f.ID,
+		Geometry:   geometry,
+		Properties: properties,
+	}, nil
+}
+
+// SetProperty sets the property, creating the properties if needed.
+func (f *Feature) SetProperty(key string, value interface{}) *Feature {
+	if f.Properties == nil {
+		f.Properties = make(map[string]interface{})
+	}
+
+	f.Properties[key] = value
+	return f
+}
+
+// Bound returns the bound of the geometry.
+func (f *Feature) Bound() *Bound {
+	return f.Geometry.Bound()
+}
+
+// ToGeoJSON creates a new geojson feature with the geometry, id and properties.
+// The properties are not copied.
+func (f *Feature) ToGeoJSON() *geojson.Feature {
+	feature := f.Geometry.ToGeoJSON()
+	feature.ID = f.ID
+
+	if f.Properties != nil {
+		feature.Properties = f.Properties
+	}
+
+	return feature
+}
+
+// A FeatureCollection is a list of features, encoded as a GeoJSON FeatureCollection.
+type FeatureCollection struct {
+	Features []*Feature
+}
+
+// NewFeatureCollection creates a new feature collection of the features.
+func NewFeatureCollection(features ...*Feature) *FeatureCollection {
+	return &FeatureCollection{Features: features}
+}
+
+// NewFeatureCollectionFromGeoJSON creates a feature collection from a geojson
+// feature collection, see NewFeatureFromGeoJSON.
+func NewFeatureCollectionFromGeoJSON(fc *geojson.FeatureCollection) (*FeatureCollection, error) {
+	features := make([]*Feature, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		feature, err := NewFeatureFromGeoJSON(f)
+		if err != nil {
+			return nil, err
+		}
+
+		features = append(features, feature)
+	}
+
+	return NewFeatureCollection(features...), nil
+}
+
+// Append adds the features to the end of the collection.
+func (fc *FeatureCollection) Append(features ...*Feature) *FeatureCollection {
+	fc.Features = append(fc.Features, features...)
+	return fc
+}
+
+// Filter returns a new collection of the features for which keep returns true.
+// The features are not copied.
+func (fc *FeatureCollection) Filter(keep func(f *Feature) bool) *FeatureCollection {
+	features := make([]*Feature, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		if keep(f) {
+			features = append(features, f)
+		}
+	}
+
+	return NewFeatureCollection(features...)
+}
+
+// Length returns the number of features in the collection.
+func (fc *FeatureCollection) Length() int {
+	return len(fc.Features)
+}
+
+// Bound returns a bound around all the features.
+// Empty collections have an empty bound at the origin.
+func (fc *FeatureCollection) Bound() *Bound {
+	bounds := make([]*Bound, len(fc.Features))
+	for i, f := range fc.Features {
+		bounds[i] = f.Bound()
+	}
+
+	return geometryBound(bounds)
+}
+
+// ToGeoJSON creates a new geojson feature collection, see Feature.ToGeoJSON.
+func (fc *FeatureCollection) ToGeoJSON() *geojson.FeatureCollection {
+	collection := geojson.NewFeatureCollection()
+	for _, f := range fc.Features {
+		collection.AddFeature(f.ToGeoJSON())
+	}
+
+	return collection
+}
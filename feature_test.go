@@ -0,0 +1,109 @@
+package geo
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/paulmach/go.geojson"
+)
+
+func TestNewFeature(t *testing.T) {
+	f := NewFeature(NewPoint(1, 2))
+	if f.Properties == nil || len(f.Properties) != 0 || f.ID != nil {
+		t.Errorf("feature, should have empty properties, got %v", f)
+	}
+
+	f = &Feature{Geometry: NewPoint(1, 2)}
+	if f.SetProperty("a", 1); f.Properties["a"] != 1 {
+		t.Errorf("feature, set property should create the properties")
+	}
+}
+
+func TestFeatureToGeoJSON(t *testing.T) {
+	f := NewFeature(testRectangle(0, 1, 0, 1)).SetProperty("name", "square")
+	f.ID = "abc"
+
+	g := f.ToGeoJSON()
+	if !g.Geometry.IsPolygon() || g.ID != "abc" || g.Properties["name"] != "square" {
+		t.Errorf("feature, geojson incorrect, got %v", g)
+	}
+
+	f2, err := NewFeatureFromGeoJSON(g)
+	if err != nil {
+		t.Fatalf("feature, from geojson error: %v", err)
+	}
+
+	if f2.ID != "abc" || f2.Properties["name"] != "square" || !f2.Bound().Equals(NewBound(0, 1, 0, 1)) {
+		t.Errorf("feature, from geojson incorrect, got %v", f2)
+	}
+
+	// nil properties
+	f2, err = NewFeatureFromGeoJSON(&geojson.Feature{Geometry: geojson.NewPointGeometry([]float64{1, 2})})
+	if err != nil || f2.Properties == nil {
+		t.Errorf("feature, from geojson should create properties, got %v %v", f2, err)
+	}
+
+	if _, err := NewFeatureFromGeoJSON(&geojson.Feature{}); err != ErrIncorrectGeometry {
+		t.Errorf("feature, from geojson without geometry should error, got %v", err)
+	}
+}
+
+func TestFeatureCollection(t *testing.T) {
+	fc := NewFeatureCollection(NewFeature(NewPoint(1, 2)).SetProperty("kind", "stop"))
+	fc.Append(
+		NewFeature(NewPoint(-3, 5)).SetProperty("kind", "depot"),
+		NewFeature(NewPoint(4, 0)).SetProperty("kind", "stop"),
+	)
+
+	if fc.Length() != 3 {
+		t.Errorf("feature collection, expected 3 features, got %d", fc.Length())
+	}
+
+	if b := fc.Bound(); !b.Equals(NewBound(-3, 4, 0, 5)) {
+		t.Errorf("feature collection, bound incorrect, got %v", b)
+	}
+
+	stops := fc.Filter(func(f *Feature) bool { return f.Properties["kind"] == "stop" })
+	if stops.Length() != 2 || stops.Features[1] != fc.Features[2] {
+		t.Errorf("feature collection, filter incorrect, got %v", stops.Features)
+	}
+
+	if fc.Length() != 3 {
+		t.Errorf("feature collection, filter should not modify the collection")
+	}
+
+	if b := NewFeatureCollection().Bound(); !b.Equals(NewBound(0, 0, 0, 0)) {
+		t.Errorf("feature collection, empty bound should be at the origin, got %v", b)
+	}
+
+	g := fc.ToGeoJSON()
+	if len(g.Features) != 3 || g.Features[1].Properties["kind"] != "depot" {
+		t.Errorf("feature collection, geojson incorrect, got %v", g)
+	}
+}
+
+func TestNewFeatureCollectionFromGeoJSON(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/geometries.geojson")
+	if err != nil {
+		t.Fatalf("unable to open fixture: %v", err)
+	}
+
+	collection, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("feature collection, unmarshal error: %v", err)
+	}
+
+	fc, err := NewFeatureCollectionFromGeoJSON(collection)
+	if err != nil {
+		t.Fatalf("feature collection, from geojson error: %v", err)
+	}
+
+	if fc.Length() != 7 || fc.Features[0].Properties["name"] != "point" {
+		t.Errorf("feature collection, from geojson incorrect, got %v", fc.Features)
+	}
+
+	collection.AddFeature(&geojson.Feature{})
+	if _, err := NewFeatureCollectionFromGeoJSON(collection); err != ErrIncorrectGeometry {
+		t.Errorf("feature collection, from geojson should error, got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A GeoBound represents a lng/lat box that may cross the anti-meridian.
+// If west is greater than east the bound wraps, going east from west past 180
+// to east. For example, a viewport over Fiji from 177 to -178.
+// Use Bound for rectangular coordinates.
+type GeoBound struct {
+	west, east, south, north float64
+}
+
+// NewGeoBound creates a new geo bound. Longitudes are normalized to [-180, 180]
+// and the bound wraps if west is greater than east. South and north can be
+// given in either order.
+func NewGeoBound(west, east, south, north float64) *GeoBound {
+	return &GeoBound{
+		west:  normalizeLng(west),
+		east:  normalizeLng(east),
+		south: math.Min(south, north),
+		north: math.Max(south, north),
+	}
+}
+
+// NewGeoBoundFromPoints creates the smallest geo bound containing the lng/lat points.
+// The bound wraps if that is narrower, eg. for points on both sides of the anti-meridian.
+// No points gives an empty bound at the origin. Use PointSet.Bound for rectangular coordinates.
+func NewGeoBoundFromPoints(points ...*Point) *GeoBound {
+	if len(points) == 0 {
+		return NewGeoBound(0, 0, 0, 0)
+	}
+
+	lngs := make([]float64, len(points))
+	south, north := points[0].Lat(), points[0].Lat()
+	for i, p := range points {
+		lngs[i] = normalizeLng(p.Lng())
+		south = math.Min(south, p.Lat())
+		north = math.Max(north, p.Lat())
+	}
+	sort.Float64s(lngs)
+
+	// the bound is everything except the largest gap between longitudes,
+	// starting with the gap across the anti-meridian so ties do not wrap.
+	west, east := lngs[0], lngs[len(lngs)-1]
+	gap := 360 - (east - west)
+	for i := 1; i < len(lngs); i++ {
+		if g := lngs[i] - lngs[i-1]; g > gap {
+			gap = g
+			west, east = lngs[i], lngs[i-1]
+		}
+	}
+
+	return NewGeoBound(west, east, south, north)
+}
+
+// West returns the western edge of the bound.
+func (b *GeoBound) West() float64 { return b.west }
+
+// East returns the eastern edge of the bound, less than West if the bound wraps.
+func (b *GeoBound) East() float64 { return b.east }
+
+// South returns the bottom of the bound.
+func (b *GeoBound) South() float64 { return b.south }
+
+// North returns the top of the bound.
+func (b *GeoBound) North() float64 { return b.north }
+
+// Wraps returns true if the bound crosses the anti-meridian.
+func (b *GeoBound) Wraps() bool {
+	return b.west > b.east
+}
+
+// Width returns the longitude span of the bound in degrees, going east from west.
+func (b *GeoBound) Width() float64 {
+	if b.Wraps() {
+		return b.east - b.west + 360
+	}
+
+	return b.east - b.west
+}
+
+// Height returns the latitude span of the bound in degrees.
+func (b *GeoBound) Height() float64 {
+	return b.north - b.south
+}
+
+// Center returns the center of the bound, on the anti-meridian side if it wraps.
+func (b *GeoBound) Center() *Point {
+	return NewPoint(normalizeLng(b.west+b.Width()/2), (b.south+b.north)/2)
+}
+
+// Contains determines if the point is within the bound.
+// Points on the boundary are considered within.
+func (b *GeoBound) Contains(point *Point) bool {
+	if point.Lat() < b.south || point.Lat() > b.north {
+		return false
+	}
+
+	lng := normalizeLng(point.Lng())
+	if b.Wraps() {
+		return lng >= b.west || lng <= b.east
+	}
+
+	return lng >= b.west && lng <= b.east
+}
+
+// ContainsBound determines if the other bound is within the bound, going around
+// the anti-meridian if needed. Bounds sharing edges are considered within,
+// so equal bounds contain each other.
+func (b *GeoBound) ContainsBound(other *GeoBound) bool {
+	if other.south < b.south || other.north > b.north {
+		return false
+	}
+
+	if b.Width() >= 360 {
+		return true
+	}
+
+	return lngOffset(b.west, other.west)+other.Width() <= b.Width()
+}
+
+// Intersects determines if two bounds intersect.
+// Returns true if they are touching.
+func (b *GeoBound) Intersects(other *GeoBound) bool {
+	for _, b1 := range b.SplitAtAntimeridian() {
+		for _, b2 := range other.SplitAtAntimeridian() {
+			if b1.Intersects(b2) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Union extends the bound to contain the other bound, in place.
+// The longitudes are extended in the direction that results in the smaller bound,
+// which may wrap the anti-meridian.
+func (b *GeoBound) Union(other *GeoBound) *GeoBound {
+	b.south = math.Min(b.south, other.south)
+	b.north = math.Max(b.north, other.north)
+
+	// spans going east from each west edge that cover both bounds
+	span1 := math.Max(b.Width(), lngOffset(b.west, other.west)+other.Width())
+	span2 := math.Max(other.Width(), lngOffset(other.west, b.west)+b.Width())
+
+	// each span ends at the east edge of one of the bounds
+	switch {
+	case math.Min(span1, span2) >= 360:
+		b.west, b.east = -180, 180
+	case span1 <= span2:
+		if span1 != b.Width() {
+			b.east = other.east
+		}
+	default:
+		if span2 == other.Width() {
+			b.east = other.east
+		}
+		b.west = other.west
+	}
+
+	return b
+}
+
+// Extend grows the bound to include the point, in place.
+// The longitudes are extended in the direction that results in the smaller bound.
+func (b *GeoBound) Extend(point *Point) *GeoBound {
+	return b.Union(NewGeoBound(point.Lng(), point.Lng(), point.Lat(), point.Lat()))
+}
+
+// Pad expands the bound in all directions by the given number of degrees, in place.
+// Latitudes are clamped to [-90, 90] and the bound spans all longitudes if padded
+// all the way around. Negative values shrink the bound, down to a zero size bound
+// at the center.
+func (b *GeoBound) Pad(degrees float64) *GeoBound {
+	center := b.Center()
+
+	if width := b.Width() + 2*degrees; width >= 360 {
+		b.west, b.east = -180, 180
+	} else if width < 0 {
+		b.west, b.east = center.Lng(), center.Lng()
+	} else {
+		b.west = normalizeLng(b.west - degrees)
+		b.east = normalizeLng(b.east + degrees)
+	}
+
+	b.south -= degrees
+	b.north += degrees
+	if b.south > b.north {
+		b.south, b.north = center.Lat(), center.Lat()
+	}
+
+	b.south = math.Max(b.south, -90)
+	b.north = math.Min(b.north, 90)
+
+	return b
+}
+
+// SplitAtAntimeridian returns the bound as one Bound, or two if it wraps,
+// for use with systems that do not support wrapping.
+func (b *GeoBound) SplitAtAntimeridian() []*Bound {
+	if b.Wraps() {
+		return []*Bound{
+			NewBound(b.west, 180, b.south, b.north),
+			NewBound(-180, b.east, b.south, b.north),
+		}
+	}
+
+	return []*Bound{NewBound(b.west, b.east, b.south, b.north)}
+}
+
+// Equals returns if two bounds are equal.
+func (b *GeoBound) Equals(other *GeoBound) bool {
+	return *b == *other
+}
+
+// Clone returns a copy of the bound.
+func (b *GeoBound) Clone() *GeoBound {
+	c := *b
+	return &c
+}
+
+// String returns the bound as [west, east, south, north].
+func (b *GeoBound) String() string {
+	return fmt.Sprintf("[%g, %g, %g, %g]", b.west, b.east, b.south, b.north)
+}
+
+// deltaLng returns the difference from lng2 to lng1 the short way
+// around the globe, for longitudes in the range [-180, 180].
+func deltaLng(lng1, lng2 float64) float64 {
+	d := lng1 - lng2
+	if d > 180 {
+		return d - 360
+	} else if d < -180 {
+		return d + 360
+	}
+
+	return d
+}
+
+// normalizeLng returns the longitude in the range [-180, 180].
+func normalizeLng(lng float64) float64 {
+	if lng >= -180 && lng <= 180 {
+		return lng
+	}
+
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+
+	return lng - 180
+}
+
+// lngOffset returns how many degrees east of west the longitude is, in [0, 360).
+func lngOffset(west, lng float64) float64 {
+	offset := math.Mod(lng-west, 360)
+	if offset < 0 {
+		offset += 360
+	}
+
+	return offset
+}
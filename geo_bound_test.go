@@ -0,0 +1,343 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewGeoBound(t *testing.T) {
+	b := NewGeoBound(177, -178, -15, -19)
+	if b.West() != 177 || b.East() != -178 || b.South() != -19 || b.North() != -15 {
+		t.Errorf("geo bound, incorrect, got %v", b)
+	}
+
+	if !b.Wraps() {
+		t.Errorf("geo bound, should wrap")
+	}
+
+	if w := b.Width(); w != 5 {
+		t.Errorf("geo bound, width expected 5, got %f", w)
+	}
+
+	if h := b.Height(); h != 4 {
+		t.Errorf("geo bound, height expected 4, got %f", h)
+	}
+
+	// longitudes are normalized
+	b = NewGeoBound(357, 182, 0, 1)
+	if b.West() != -3 || b.East() != -178 || !b.Wraps() {
+		t.Errorf("geo bound, longitudes should be normalized, got %v", b)
+	}
+
+	b = NewGeoBound(-180, 180, -90, 90)
+	if b.Wraps() || b.Width() != 360 {
+		t.Errorf("geo bound, full world should not wrap, got %v", b)
+	}
+}
+
+func TestNewGeoBoundFromPoints(t *testing.T) {
+	cases := []struct {
+		points   []*Point
+		expected *GeoBound
+	}{
+		{[]*Point{NewPoint(10, 5), NewPoint(-20, 1), NewPoint(15, -3)}, NewGeoBound(-20, 15, -3, 5)},
+		{[]*Point{NewPoint(178, -17), NewPoint(-179, -16), NewPoint(179.5, -18)}, NewGeoBound(178, -179, -18, -16)},
+		{[]*Point{NewPoint(-170, 0), NewPoint(170, 0), NewPoint(-175, 1)}, NewGeoBound(170, -170, 0, 1)},
+		{[]*Point{NewPoint(540, 1)}, NewGeoBound(-180, -180, 1, 1)},
+		{[]*Point{NewPoint(-90, 0), NewPoint(90, 0)}, NewGeoBound(-90, 90, 0, 0)},
+		{[]*Point{NewPoint(-120, 0), NewPoint(0, 0), NewPoint(120, 0)}, NewGeoBound(-120, 120, 0, 0)},
+		{nil, NewGeoBound(0, 0, 0, 0)},
+	}
+
+	for i, tc := range cases {
+		b := NewGeoBoundFromPoints(tc.points...)
+		if !b.Equals(tc.expected) {
+			t.Errorf("geo bound, from points %d expected %v, got %v", i, tc.expected, b)
+		}
+
+		for _, p := range tc.points {
+			if !b.Contains(p) {
+				t.Errorf("geo bound, from points %d should contain %v", i, p)
+			}
+		}
+	}
+
+	// a planar bound would span the globe
+	points := []*Point{NewPoint(179, 0), NewPoint(-179, 0)}
+	if w := NewGeoBoundFromPoints(points...).Width(); w != 2 {
+		t.Errorf("geo bound, from points across the anti-meridian expected width 2, got %v", w)
+	}
+}
+
+func TestGeoBoundCenter(t *testing.T) {
+	cases := []struct {
+		bound    *GeoBound
+		expected *Point
+	}{
+		{NewGeoBound(0, 10, 0, 10), NewPoint(5, 5)},
+		{NewGeoBound(179, -179, -10, 10), NewPoint(180, 0)},
+		{NewGeoBound(170, -160, 0, 0), NewPoint(-175, 0)},
+		{NewGeoBound(-180, 180, -90, 90), NewPoint(0, 0)},
+	}
+
+	for _, tc := range cases {
+		if c := tc.bound.Center(); !c.Equals(tc.expected) {
+			t.Errorf("geo bound, center of %v expected %v, got %v", tc.bound, tc.expected, c)
+		}
+	}
+}
+
+func TestGeoBoundContains(t *testing.T) {
+	fiji := NewGeoBound(177, -178, -19, -15)
+	normal := NewGeoBound(-10, 10, -10, 10)
+
+	cases := []struct {
+		bound    *GeoBound
+		point    *Point
+		expected bool
+	}{
+		{fiji, NewPoint(178, -17), true},
+		{fiji, NewPoint(-179, -17), true},
+		{fiji, NewPoint(180, -17), true},
+		{fiji, NewPoint(-180, -17), true},
+		{fiji, NewPoint(181, -17), true},
+		{fiji, NewPoint(177, -15), true},
+		{fiji, NewPoint(-178, -19), true},
+		{fiji, NewPoint(0, -17), false},
+		{fiji, NewPoint(176, -17), false},
+		{fiji, NewPoint(-177, -17), false},
+		{fiji, NewPoint(178, -20), false},
+		{normal, NewPoint(0, 0), true},
+		{normal, NewPoint(10, 10), true},
+		{normal, NewPoint(180, 0), false},
+		{normal, NewPoint(370, 0), true},
+	}
+
+	for _, tc := range cases {
+		if v := tc.bound.Contains(tc.point); v != tc.expected {
+			t.Errorf("geo bound, %v contains %v expected %v", tc.bound, tc.point, tc.expected)
+		}
+	}
+}
+
+func TestGeoBoundContainsBound(t *testing.T) {
+	fiji := NewGeoBound(177, -178, -19, -15)
+
+	cases := []struct {
+		name     string
+		bound    *GeoBound
+		other    *GeoBound
+		expected bool
+	}{
+		{"equal", fiji, NewGeoBound(177, -178, -19, -15), true},
+		{"west side", fiji, NewGeoBound(177, 179, -18, -16), true},
+		{"east side", fiji, NewGeoBound(-179, -178, -18, -16), true},
+		{"across", fiji, NewGeoBound(179, -179, -18, -16), true},
+		{"point on edge", fiji, NewGeoBound(-178, -178, -15, -15), true},
+		{"overlapping", fiji, NewGeoBound(176, 179, -18, -16), false},
+		{"outside", fiji, NewGeoBound(-10, 10, -18, -16), false},
+		{"latitude", fiji, NewGeoBound(178, 179, -20, -16), false},
+		{"around the other way", fiji, NewGeoBound(-178, 177, -18, -16), false},
+		{"world contains", NewGeoBound(-180, 180, -90, 90), fiji, true},
+		{"world not contained", fiji, NewGeoBound(-180, 180, -18, -16), false},
+		{"normal in wrapping", NewGeoBound(10, 5, 0, 1), NewGeoBound(-10, 0, 0, 1), true},
+		{"normal not in wrapping", NewGeoBound(10, 5, 0, 1), NewGeoBound(0, 20, 0, 1), false},
+	}
+
+	for _, tc := range cases {
+		if v := tc.bound.ContainsBound(tc.other); v != tc.expected {
+			t.Errorf("geo bound, contains bound %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		// consistent with Contains
+		corners := []*Point{
+			NewPoint(tc.other.West(), tc.other.South()),
+			NewPoint(tc.other.East(), tc.other.North()),
+			tc.other.Center(),
+		}
+
+		for _, p := range corners {
+			if tc.expected && !tc.bound.Contains(p) {
+				t.Errorf("geo bound, contains bound %s should contain %v", tc.name, p)
+			}
+		}
+	}
+}
+
+func TestGeoBoundIntersects(t *testing.T) {
+	fiji := NewGeoBound(177, -178, -19, -15)
+
+	cases := []struct {
+		name     string
+		other    *GeoBound
+		expected bool
+	}{
+		{"other wrapping", NewGeoBound(179, -170, -20, -10), true},
+		{"west side", NewGeoBound(170, 178, -20, -10), true},
+		{"east side", NewGeoBound(-179, -170, -20, -10), true},
+		{"touching west", NewGeoBound(170, 177, -20, -10), true},
+		{"touching east", NewGeoBound(-178, -170, -20, -10), true},
+		{"between", NewGeoBound(-170, 170, -20, -10), false},
+		{"wrapping around", NewGeoBound(176, 176.5, -20, -10), false},
+		{"latitude", NewGeoBound(178, 179, 0, 10), false},
+		{"world", NewGeoBound(-180, 180, -90, 90), true},
+	}
+
+	for _, tc := range cases {
+		if v := fiji.Intersects(tc.other); v != tc.expected {
+			t.Errorf("geo bound, intersects %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+
+		if v := tc.other.Intersects(fiji); v != tc.expected {
+			t.Errorf("geo bound, intersects %s should be symmetric", tc.name)
+		}
+	}
+}
+
+func TestGeoBoundUnion(t *testing.T) {
+	cases := []struct {
+		name     string
+		b1, b2   *GeoBound
+		expected *GeoBound
+	}{
+		{
+			name:     "across anti-meridian is shorter",
+			b1:       NewGeoBound(170, 175, 0, 1),
+			b2:       NewGeoBound(-175, -170, 2, 3),
+			expected: NewGeoBound(170, -170, 0, 3),
+		},
+		{
+			name:     "across prime meridian is shorter",
+			b1:       NewGeoBound(-10, -5, 0, 1),
+			b2:       NewGeoBound(5, 10, 0, 1),
+			expected: NewGeoBound(-10, 10, 0, 1),
+		},
+		{
+			name:     "inside",
+			b1:       NewGeoBound(170, -170, 0, 1),
+			b2:       NewGeoBound(175, 179, 0, 1),
+			expected: NewGeoBound(170, -170, 0, 1),
+		},
+		{
+			name:     "overlapping west",
+			b1:       NewGeoBound(170, -170, 0, 1),
+			b2:       NewGeoBound(160, 175, 0, 1),
+			expected: NewGeoBound(160, -170, 0, 1),
+		},
+		{
+			name:     "overlapping east",
+			b1:       NewGeoBound(170, -170, 0, 1),
+			b2:       NewGeoBound(-175, -160, 0, 1),
+			expected: NewGeoBound(170, -160, 0, 1),
+		},
+		{
+			name:     "covers everything",
+			b1:       NewGeoBound(0, -10, 0, 1),
+			b2:       NewGeoBound(-20, 5, 0, 1),
+			expected: NewGeoBound(-180, 180, 0, 1),
+		},
+		{
+			name:     "with world",
+			b1:       NewGeoBound(170, -170, 0, 1),
+			b2:       NewGeoBound(-180, 180, 0, 1),
+			expected: NewGeoBound(-180, 180, 0, 1),
+		},
+	}
+
+	for _, tc := range cases {
+		if b := tc.b1.Clone().Union(tc.b2); !b.Equals(tc.expected) {
+			t.Errorf("geo bound, union %s expected %v, got %v", tc.name, tc.expected, b)
+		}
+
+		if b := tc.b2.Clone().Union(tc.b1); !b.Equals(tc.expected) {
+			t.Errorf("geo bound, union %s should be symmetric, expected %v, got %v", tc.name, tc.expected, b)
+		}
+	}
+}
+
+func TestGeoBoundExtend(t *testing.T) {
+	b := NewGeoBound(178, 179, -17, -16)
+
+	b.Extend(NewPoint(-179, -18))
+	if !b.Equals(NewGeoBound(178, -179, -18, -16)) {
+		t.Errorf("geo bound, extend should wrap, got %v", b)
+	}
+
+	b.Extend(NewPoint(179.5, -17))
+	if !b.Equals(NewGeoBound(178, -179, -18, -16)) {
+		t.Errorf("geo bound, extend with contained point should not change, got %v", b)
+	}
+
+	b.Extend(NewPoint(170, -17))
+	if !b.Equals(NewGeoBound(170, -179, -18, -16)) {
+		t.Errorf("geo bound, extend west incorrect, got %v", b)
+	}
+}
+
+func TestGeoBoundPad(t *testing.T) {
+	b := NewGeoBound(179, -179, 10, 20).Pad(2)
+	if !b.Equals(NewGeoBound(177, -177, 8, 22)) {
+		t.Errorf("geo bound, pad incorrect, got %v", b)
+	}
+
+	b = NewGeoBound(-170, 170, 80, 85).Pad(15)
+	if !b.Equals(NewGeoBound(-180, 180, 65, 90)) {
+		t.Errorf("geo bound, pad all the way around should span all longitudes, got %v", b)
+	}
+
+	b = NewGeoBound(179, -179, 10, 20).Pad(-3)
+	if !b.Equals(NewGeoBound(180, 180, 13, 17)) {
+		t.Errorf("geo bound, negative pad should shrink longitudes to the center, got %v", b)
+	}
+
+	b = NewGeoBound(170, -170, 10, 20).Pad(-5)
+	if !b.Equals(NewGeoBound(175, -175, 15, 15)) {
+		t.Errorf("geo bound, negative pad incorrect, got %v", b)
+	}
+}
+
+func TestGeoBoundSplitAtAntimeridian(t *testing.T) {
+	bounds := NewGeoBound(177, -178, -19, -15).SplitAtAntimeridian()
+	if len(bounds) != 2 {
+		t.Fatalf("geo bound, wrapping bound should split in 2, got %v", bounds)
+	}
+
+	if !bounds[0].Equals(NewBound(177, 180, -19, -15)) || !bounds[1].Equals(NewBound(-180, -178, -19, -15)) {
+		t.Errorf("geo bound, split incorrect, got %v", bounds)
+	}
+
+	bounds = NewGeoBound(-10, 10, 0, 1).SplitAtAntimeridian()
+	if len(bounds) != 1 || !bounds[0].Equals(NewBound(-10, 10, 0, 1)) {
+		t.Errorf("geo bound, non wrapping bound should not split, got %v", bounds)
+	}
+
+	// total width is preserved
+	b := NewGeoBound(100, -100, 0, 1)
+	total := 0.0
+	for _, s := range b.SplitAtAntimeridian() {
+		total += s.Width()
+	}
+
+	if math.Abs(total-b.Width()) > epsilon {
+		t.Errorf("geo bound, split width expected %f, got %f", b.Width(), total)
+	}
+}
+
+func TestNormalizeLng(t *testing.T) {
+	cases := map[float64]float64{
+		0:    0,
+		180:  180,
+		-180: -180,
+		181:  -179,
+		-181: 179,
+		540:  -180,
+		720:  0,
+		-370: -10,
+	}
+
+	for lng, expected := range cases {
+		if v := normalizeLng(lng); v != expected {
+			t.Errorf("normalize lng %f expected %f, got %f", lng, expected, v)
+		}
+	}
+}
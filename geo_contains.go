@@ -0,0 +1,173 @@
+package geo
+
+import "math"
+
+// sphereVector is a point on the unit sphere in earth centered coordinates.
+type sphereVector [3]float64
+
+// geoContainsOffset is the distance, in radians, from an edge to the reference
+// point known to be on its left, about 6mm on the earth.
+const geoContainsOffset = 1e-9
+
+// GeoContains returns true if the point is inside the path, treated as a closed
+// polygon with the edges being great circle arcs. The path and point must be in
+// lng/lat (EPSG:4326). Unlike Contains, edges follow the curvature of the earth, so
+// the answer is correct near long edges at high latitudes. Polygons crossing the
+// antimeridian or containing a pole are supported, no continuous longitudes needed.
+// A ring divides the sphere in two, the inside is the smaller of the two regions,
+// independent of the winding. Points on a vertex are not contained, points exactly
+// on an edge may be either. Edges must be shorter than 180 degrees.
+func (p *Path) GeoContains(point *Point) bool {
+	ring := geoContainsRing(p.PointSet)
+	if len(ring) < 3 {
+		return false
+	}
+
+	v := newSphereVector(point)
+	for _, r := range ring {
+		if r == v {
+			return false
+		}
+	}
+
+	return geoContainsVector(ring, v)
+}
+
+// GeoContains returns true if the point is inside the exterior and outside of
+// the holes, using Path.GeoContains for each ring. The polygon and point must be
+// in lng/lat (EPSG:4326).
+func (p *Polygon) GeoContains(point *Point) bool {
+	if !p.exterior.GeoContains(point) {
+		return false
+	}
+
+	for _, hole := range p.holes {
+		if hole.GeoContains(point) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// geoContainsRing returns the points as unit vectors without consecutive
+// duplicates, including the closing point.
+func geoContainsRing(points PointSet) []sphereVector {
+	ring := make([]sphereVector, 0, len(points))
+	for i := range points {
+		v := newSphereVector(&points[i])
+		if len(ring) == 0 || ring[len(ring)-1] != v {
+			ring = append(ring, v)
+		}
+	}
+
+	for len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+
+	return ring
+}
+
+// geoContainsVector counts the edges crossed by the arc from a reference point,
+// with a known status, to the vector.
+func geoContainsVector(ring []sphereVector, v sphereVector) bool {
+	// the area of the region to the left of the edges is 2pi minus the total turning,
+	// on the unit sphere, so it is the smaller region if the edges turn left overall
+	turning := 0.0
+	longest, length := 0, -1.0
+	for i := range ring {
+		a, b, c := ring[i], ring[(i+1)%len(ring)], ring[(i+2)%len(ring)]
+
+		n1, n2 := a.cross(b), b.cross(c)
+		turning += math.Atan2(n1.cross(n2).dot(b), n1.dot(n2))
+
+		if l := n1.dot(n1); l > length {
+			longest, length = i, l
+		}
+	}
+
+	// a reference point just left of the middle of the longest edge
+	a, b := ring[longest], ring[(longest+1)%len(ring)]
+	ref := a.add(b).normalize().add(a.cross(b).normalize().scale(geoContainsOffset)).normalize()
+	inside := turning >= 0
+
+	// the arc must not be between antipodal points, go around if needed
+	path := []sphereVector{ref, v}
+	if ref.dot(v) < -0.5 {
+		path = []sphereVector{ref, ref.orthogonal(), v}
+	}
+
+	for i := 0; i < len(path)-1; i++ {
+		c, d := path[i], path[i+1]
+		for j := range ring {
+			if sphereArcsCross(ring[j], ring[(j+1)%len(ring)], c, d) {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// sphereArcsCross returns true if the arc from a to b crosses the arc from c to d.
+// Vertices on the other great circle are treated as being to its left so
+// an arc passing through a vertex crosses exactly one of its edges, or neither.
+func sphereArcsCross(a, b, c, d sphereVector) bool {
+	cd := c.cross(d)
+	if (cd.dot(a) >= 0) == (cd.dot(b) >= 0) {
+		return false
+	}
+
+	ab := a.cross(b)
+	if (ab.dot(c) >= 0) == (ab.dot(d) >= 0) {
+		return false
+	}
+
+	// the great circles intersect at two antipodal points,
+	// the arcs must cross at the same one
+	x := ab.cross(cd)
+	return (x.dot(a.add(b)) > 0) == (x.dot(c.add(d)) > 0)
+}
+
+func newSphereVector(p *Point) sphereVector {
+	lng, lat := deg2rad(p[0]), deg2rad(p[1])
+	return sphereVector{
+		math.Cos(lat) * math.Cos(lng),
+		math.Cos(lat) * math.Sin(lng),
+		math.Sin(lat),
+	}
+}
+
+func (v sphereVector) add(w sphereVector) sphereVector {
+	return sphereVector{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+func (v sphereVector) scale(s float64) sphereVector {
+	return sphereVector{v[0] * s, v[1] * s, v[2] * s}
+}
+
+func (v sphereVector) dot(w sphereVector) float64 {
+	return v[0]*w[0] + v[1]*w[1] + v[2]*w[2]
+}
+
+func (v sphereVector) cross(w sphereVector) sphereVector {
+	return sphereVector{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}
+}
+
+func (v sphereVector) normalize() sphereVector {
+	return v.scale(1 / math.Sqrt(v.dot(v)))
+}
+
+// orthogonal returns a unit vector perpendicular to the vector.
+func (v sphereVector) orthogonal() sphereVector {
+	axis := sphereVector{1, 0, 0}
+	if math.Abs(v[0]) > math.Abs(v[1]) {
+		axis = sphereVector{0, 1, 0}
+	}
+
+	return v.cross(axis).normalize()
+}
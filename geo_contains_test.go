@@ -0,0 +1,166 @@
+package geo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// testCanada is a rough outline of Canada with long northern and southern edges.
+func testCanada() *Path {
+	return NewPathFromXYData([][2]float64{
+		{-141, 60}, {-141, 69.6}, {-62, 82}, {-55, 52}, {-67, 45},
+		{-83, 42}, {-95, 49}, {-123, 49}, {-130, 55}, {-141, 60},
+	})
+}
+
+func TestPathGeoContains(t *testing.T) {
+	canada := testCanada()
+
+	cases := []struct {
+		point  *Point
+		planar bool
+		geo    bool
+	}{
+		{NewPoint(-100, 60), true, true},
+		{NewPoint(-75, 50), true, true},
+		{NewPoint(-100, 40), false, false},
+		{NewPoint(0, 0), false, false},
+		// north of the planar edge, south of the great circle
+		{NewPoint(-101.5, 77), false, true},
+		{NewPoint(-101.5, 80), false, true},
+		// the 49th parallel edge is a great circle bulging north
+		{NewPoint(-109, 49.3), true, false},
+		{NewPoint(-109, 49.6), true, false},
+	}
+
+	for _, tc := range cases {
+		if v := canada.Contains(tc.point); v != tc.planar {
+			t.Errorf("path, contains %v expected %v, got %v", tc.point, tc.planar, v)
+		}
+
+		if v := canada.GeoContains(tc.point); v != tc.geo {
+			t.Errorf("path, geo contains %v expected %v, got %v", tc.point, tc.geo, v)
+		}
+
+		if v := canada.Clone().Reverse().GeoContains(tc.point); v != tc.geo {
+			t.Errorf("path, geo contains %v should not depend on winding, got %v", tc.point, v)
+		}
+	}
+
+	// not closed
+	open := testCanada()
+	open.Pop()
+	if !open.GeoContains(NewPoint(-101.5, 77)) {
+		t.Errorf("path, geo contains should close the path")
+	}
+
+	// on a vertex
+	if canada.GeoContains(NewPoint(-62, 82)) {
+		t.Errorf("path, geo contains should be false for a vertex")
+	}
+
+	if NewPath().GeoContains(NewPoint(0, 0)) {
+		t.Errorf("path, geo contains should be false for empty path")
+	}
+
+	if NewPathFromXYData([][2]float64{{0, 0}, {1, 1}}).GeoContains(NewPoint(0.5, 0.5)) {
+		t.Errorf("path, geo contains should be false for a line")
+	}
+}
+
+func TestPathGeoContainsPole(t *testing.T) {
+	north := NewPathFromXYData([][2]float64{
+		{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80},
+	})
+
+	south := NewPathFromXYData([][2]float64{
+		{0, -70}, {120, -70}, {-120, -70}, {0, -70},
+	})
+
+	cases := []struct {
+		path   *Path
+		point  *Point
+		result bool
+	}{
+		{north, NewPoint(0, 90), true},
+		{north, NewPoint(45, 85), true},
+		{north, NewPoint(-170, 85), true},
+		{north, NewPoint(-135, 81), false}, // edges bulge towards the pole
+		{north, NewPoint(0, 0), false},
+		{north, NewPoint(0, -90), false},
+		{south, NewPoint(0, -90), true},
+		{south, NewPoint(60, -82), true},
+		{south, NewPoint(60, -75), false},
+		{south, NewPoint(60, -65), false},
+		{south, NewPoint(0, 90), false},
+	}
+
+	for _, tc := range cases {
+		if v := tc.path.GeoContains(tc.point); v != tc.result {
+			t.Errorf("path, geo contains %v expected %v, got %v", tc.point, tc.result, v)
+		}
+
+		if v := tc.path.Clone().Reverse().GeoContains(tc.point); v != tc.result {
+			t.Errorf("path, geo contains reversed %v expected %v, got %v", tc.point, tc.result, v)
+		}
+	}
+}
+
+func TestPathGeoContainsAntimeridian(t *testing.T) {
+	path := NewPathFromXYData([][2]float64{
+		{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10},
+	})
+
+	cases := []struct {
+		point  *Point
+		result bool
+	}{
+		{NewPoint(180, 0), true},
+		{NewPoint(-180, 0), true},
+		{NewPoint(-175, 5), true},
+		{NewPoint(175, -5), true},
+		{NewPoint(0, 0), false},
+		{NewPoint(-165, 0), false},
+		{NewPoint(165, 0), false},
+	}
+
+	for _, tc := range cases {
+		if v := path.GeoContains(tc.point); v != tc.result {
+			t.Errorf("path, geo contains %v expected %v, got %v", tc.point, tc.result, v)
+		}
+	}
+}
+
+func TestPathGeoContainsSmall(t *testing.T) {
+	// small polygons should match the planar result
+	path := NewPathFromXYData([][2]float64{
+		{-122.5, 37.7}, {-122.4, 37.7}, {-122.45, 37.75}, {-122.4, 37.8}, {-122.5, 37.8}, {-122.5, 37.7},
+	})
+
+	r := rand.New(rand.NewSource(42))
+	bound := path.Bound().Pad(0.02)
+	for i := 0; i < 1000; i++ {
+		p := bound.RandomPoint(r)
+		if path.GeoContains(p) != path.Contains(p) {
+			t.Errorf("path, geo contains %v should match planar contains", p)
+		}
+	}
+}
+
+func TestPolygonGeoContains(t *testing.T) {
+	polygon := NewPolygon(testCanada(), NewPathFromXYData([][2]float64{
+		{-100, 55}, {-90, 55}, {-90, 60}, {-100, 60}, {-100, 55},
+	}))
+
+	if !polygon.GeoContains(NewPoint(-101.5, 77)) {
+		t.Errorf("polygon, geo contains should be true near the northern edge")
+	}
+
+	if polygon.GeoContains(NewPoint(-95, 57)) {
+		t.Errorf("polygon, geo contains should be false in the hole")
+	}
+
+	if polygon.GeoContains(NewPoint(-109, 49.3)) {
+		t.Errorf("polygon, geo contains should be false near the southern edge")
+	}
+}
@@ -0,0 +1,115 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrTooManyGeoHashes is returned when a bound is covered by more than the allowed number of geohashes.
+var ErrTooManyGeoHashes = errors.New("go.geo: too many geohashes")
+
+// GeoHashOptions are the options used when finding the geohashes covering a bound.
+type GeoHashOptions struct {
+	// MaxHashes is the maximum number of geohashes to return, 0 for no limit.
+	MaxHashes int
+}
+
+// GeoHashes returns the geohashes, of the given number of characters, that cover
+// the lng/lat bound. Every point within the bound has a Point.GeoHash in the result,
+// so cells straddling the edges are included. Points on a cell boundary hash to the
+// cell to the west or south, so a bound with its west or south edge on a boundary
+// includes those neighbors. Hashes are ordered south to north,
+// then west to east. Panics if precision is not in [1, 12].
+func (b *Bound) GeoHashes(precision int) []string {
+	hashes, _ := b.GeoHashesWithOptions(precision, GeoHashOptions{})
+	return hashes
+}
+
+// GeoHashesWithOptions returns the geohashes, of the given number of characters,
+// that cover the lng/lat bound. Returns ErrTooManyGeoHashes if there are more than opts.MaxHashes.
+func (b *Bound) GeoHashesWithOptions(precision int, opts GeoHashOptions) ([]string, error) {
+	if precision < 1 || precision > 12 {
+		panic("geo: geohash precision must be in [1, 12]")
+	}
+
+	lngBits := uint(5*precision+1) / 2
+	latBits := uint(5*precision) / 2
+
+	minX, maxX := geoHashCellRange(b.sw[0], b.ne[0], -180, 180, lngBits)
+	minY, maxY := geoHashCellRange(b.sw[1], b.ne[1], -90, 90, latBits)
+
+	count := (maxX - minX + 1) * (maxY - minY + 1)
+	if opts.MaxHashes > 0 && count > int64(opts.MaxHashes) {
+		return nil, ErrTooManyGeoHashes
+	}
+
+	hashes := make([]string, 0, count)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			// interleave the bits, starting with longitude
+			var hash int64
+			for i := 5*precision - 1; i >= 0; i-- {
+				hash <<= 1
+				if i%2 == 5*precision%2 {
+					hash |= (y >> uint(i/2)) & 1
+				} else {
+					hash |= (x >> uint(i/2)) & 1
+				}
+			}
+
+			hashes = append(hashes, geoHashString(hash, precision))
+		}
+	}
+
+	return hashes, nil
+}
+
+// GeoHashCover returns the geohashes, of the given number of characters, that cover
+// the lng/lat bound, see GeoHashes. The hashes are sorted so they can be used
+// as index key ranges. Panics if precision is not in [1, 12].
+func (b *Bound) GeoHashCover(precision int) []string {
+	hashes, _ := b.GeoHashCoverWithOptions(precision, GeoHashOptions{})
+	return hashes
+}
+
+// GeoHashCoverWithOptions returns the sorted geohashes, of the given number of characters,
+// that cover the lng/lat bound. Returns ErrTooManyGeoHashes if there are more than opts.MaxHashes.
+func (b *Bound) GeoHashCoverWithOptions(precision int, opts GeoHashOptions) ([]string, error) {
+	hashes, err := b.GeoHashesWithOptions(precision, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(hashes)
+	return hashes, nil
+}
+
+// NewBoundFromGeoHashes creates a new bound containing all the geohash cells.
+// Panics if there are no hashes.
+func NewBoundFromGeoHashes(hashes []string) *Bound {
+	if len(hashes) == 0 {
+		panic("geo: no geohashes to create bound from")
+	}
+
+	b := NewBoundFromGeoHash(hashes[0])
+	for _, hash := range hashes[1:] {
+		b.Union(NewBoundFromGeoHash(hash))
+	}
+
+	return b
+}
+
+// geoHashCellRange returns the range of cell indexes, in a grid of 2^bits cells,
+// of the values. Values on a cell boundary are in the lower cell, same as Point.GeoHash.
+func geoHashCellRange(start, end, min, max float64, bits uint) (int64, int64) {
+	n := float64(int64(1) << bits)
+	size := (max - min) / n
+
+	cell := func(v float64) int64 {
+		c := math.Ceil((v-min)/size) - 1
+		return int64(math.Max(0, math.Min(n-1, c)))
+	}
+
+	return cell(start), cell(end)
+}
@@ -0,0 +1,154 @@
+package geo
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestBoundGeoHashes(t *testing.T) {
+	// smaller than a cell
+	p := NewPoint(-122.4194, 37.7749)
+	bound := NewBoundFromGeoHash(p.GeoHash(5)).Pad(-1e-5)
+
+	if hashes := bound.GeoHashes(5); !reflect.DeepEqual(hashes, []string{p.GeoHash(5)}) {
+		t.Errorf("geohashes, expected single cell %s, got %v", p.GeoHash(5), hashes)
+	}
+
+	// corner of the equator and prime meridian
+	bound = NewBound(-0.1, 0.1, -0.1, 0.1)
+	expected := []string{"7", "k", "e", "s"}
+	if hashes := bound.GeoHashes(1); !reflect.DeepEqual(hashes, expected) {
+		t.Errorf("geohashes, seam expected %v, got %v", expected, hashes)
+	}
+
+	for _, h := range expected {
+		if !bound.Intersects(NewBoundFromGeoHash(h)) {
+			t.Errorf("geohashes, %s should intersect bound", h)
+		}
+	}
+
+	// the world
+	if hashes := NewBound(-180, 180, -90, 90).GeoHashes(1); len(hashes) != 32 {
+		t.Errorf("geohashes, world expected 32 cells, got %d", len(hashes))
+	}
+}
+
+func TestBoundGeoHashesCoverPoints(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bound := NewBound(-0.3, 0.2, 51.4, 51.6)
+
+	for precision := 1; precision <= 6; precision++ {
+		hashes := bound.GeoHashes(precision)
+
+		set := make(map[string]bool)
+		for _, h := range hashes {
+			if set[h] {
+				t.Errorf("geohashes, precision %d has duplicate %s", precision, h)
+			}
+			set[h] = true
+
+			if len(h) != precision {
+				t.Errorf("geohashes, expected %d characters, got %s", precision, h)
+			}
+		}
+
+		// corners and random points hash to a returned cell
+		points := []*Point{bound.SouthWest(), bound.SouthEast(), bound.NorthWest(), bound.NorthEast()}
+		for i := 0; i < 100; i++ {
+			points = append(points, bound.RandomPoint(r))
+		}
+
+		for _, p := range points {
+			if h := p.GeoHash(precision); !set[h] {
+				t.Errorf("geohashes, precision %d missing %s for point %v", precision, h, p)
+			}
+		}
+
+		if c := NewBoundFromGeoHashes(hashes); !c.ContainsBound(bound) {
+			t.Errorf("geohashes, precision %d cells should contain bound, got %v", precision, c)
+		}
+	}
+}
+
+func TestBoundGeoHashesWithOptions(t *testing.T) {
+	bound := NewBound(-0.3, 0.2, 51.4, 51.6)
+
+	hashes, err := bound.GeoHashesWithOptions(3, GeoHashOptions{MaxHashes: 10})
+	if err != nil || len(hashes) == 0 {
+		t.Errorf("geohashes, expected hashes, got %v, %v", hashes, err)
+	}
+
+	hashes, err = bound.GeoHashesWithOptions(8, GeoHashOptions{MaxHashes: 10})
+	if err != ErrTooManyGeoHashes || hashes != nil {
+		t.Errorf("geohashes, expected too many error, got %d, %v", len(hashes), err)
+	}
+}
+
+func TestBoundGeoHashCover(t *testing.T) {
+	// the cover of a point contains its geohash
+	points := []*Point{
+		NewPoint(0, 0),
+		NewPoint(-122.4194, 37.7749),
+		NewPoint(180, 90),
+		NewPoint(-180, -90),
+		NewPoint(-45, 45),
+	}
+
+	for _, p := range points {
+		for precision := 1; precision <= 12; precision++ {
+			cover := NewBound(p[0], p[0], p[1], p[1]).GeoHashCover(precision)
+			if h := p.GeoHash(precision); !reflect.DeepEqual(cover, []string{h}) {
+				t.Errorf("geohash cover, point %v at %d expected [%s], got %v", p, precision, h, cover)
+			}
+		}
+	}
+
+	// the same cells as GeoHashes, sorted
+	bound := NewBound(-0.3, 0.2, 51.4, 51.6)
+	for precision := 1; precision <= 5; precision++ {
+		cover := bound.GeoHashCover(precision)
+		if !sort.StringsAreSorted(cover) {
+			t.Errorf("geohash cover, precision %d should be sorted, got %v", precision, cover)
+		}
+
+		hashes := bound.GeoHashes(precision)
+		sort.Strings(hashes)
+		if !reflect.DeepEqual(cover, hashes) {
+			t.Errorf("geohash cover, precision %d expected %v, got %v", precision, hashes, cover)
+		}
+	}
+
+	// the children of a cell
+	cover := NewBoundFromGeoHash("9q").Pad(-1e-9).GeoHashCover(3)
+	if len(cover) != 32 || cover[0] != "9q0" || cover[31] != "9qz" {
+		t.Errorf("geohash cover, children expected 32 cells, got %v", cover)
+	}
+
+	if _, err := bound.GeoHashCoverWithOptions(8, GeoHashOptions{MaxHashes: 10}); err != ErrTooManyGeoHashes {
+		t.Errorf("geohash cover, expected too many error, got %v", err)
+	}
+}
+
+func TestBoundGeoHashesPrecisionPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("geohashes, should panic for precision out of range")
+		}
+	}()
+
+	NewBound(0, 1, 0, 1).GeoHashes(13)
+}
+
+func TestNewBoundFromGeoHashes(t *testing.T) {
+	b := NewBoundFromGeoHashes([]string{"7", "s"})
+	if !b.Equals(NewBound(-45, 45, -45, 45)) {
+		t.Errorf("geohashes, bound incorrect, got %v", b)
+	}
+
+	b = NewBoundFromGeoHashes([]string{"9q8yy"})
+	if !b.Equals(NewBoundFromGeoHash("9q8yy")) {
+		t.Errorf("geohashes, single hash bound incorrect, got %v", b)
+	}
+}
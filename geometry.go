@@ -0,0 +1,381 @@
+package geo
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/paulmach/go.geojson"
+)
+
+// A Geometry is the interface shared by the types that can be encoded as
+// GeoJSON and WKT geometries, ie. *Point, *Line, *Path, *Polygon and the
+// MultiPoint, MultiPath, MultiPolygon and Collection containers.
+type Geometry interface {
+	Bound() *Bound
+	ToGeoJSON() *geojson.Feature
+	ToWKT() string
+	DistanceFrom(point *Point) float64
+}
+
+// MultiPoint is a set of points, encoded as a GeoJSON or WKT MultiPoint.
+// A PointSet can be converted using MultiPoint(ps).
+type MultiPoint []Point
+
+// MultiPath is a set of paths, encoded as a GeoJSON or WKT MultiLineString.
+type MultiPath []*Path
+
+// MultiPolygon is a set of polygons, encoded as a GeoJSON or WKT MultiPolygon.
+type MultiPolygon []*Polygon
+
+// Collection is a set of geometries of any type, encoded as a
+// GeoJSON or WKT GeometryCollection.
+type Collection []Geometry
+
+// NewGeometryFromGeoJSON creates the geometry of the matching type, ie. a Point becomes
+// a *Point, a LineString a *Path, a MultiLineString a MultiPath and a GeometryCollection
+// a Collection. Any extra values of the positions, like elevation, are ignored.
+// Returns ErrIncorrectGeometry for unknown geometry types.
+func NewGeometryFromGeoJSON(g *geojson.Geometry) (Geometry, error) {
+	if g == nil {
+		return nil, ErrIncorrectGeometry
+	}
+
+	switch {
+	case g.IsPoint():
+		if len(g.Point) < 2 {
+			return nil, ErrIncorrectGeometry
+		}
+		return NewPoint(g.Point[0], g.Point[1]), nil
+	case g.IsMultiPoint():
+		return MultiPoint(NewPathFromXYSlice(g.MultiPoint).PointSet), nil
+	case g.IsLineString():
+		return NewPathFromXYSlice(g.LineString), nil
+	case g.IsMultiLineString():
+		mp := make(MultiPath, 0, len(g.MultiLineString))
+		for _, coords := range g.MultiLineString {
+			mp = append(mp, NewPathFromXYSlice(coords))
+		}
+		return mp, nil
+	case g.IsPolygon():
+		return newPolygonFromXYSlices(g.Polygon), nil
+	case g.IsMultiPolygon():
+		mp := make(MultiPolygon, 0, len(g.MultiPolygon))
+		for _, coords := range g.MultiPolygon {
+			mp = append(mp, newPolygonFromXYSlices(coords))
+		}
+		return mp, nil
+	case g.IsCollection():
+		c := make(Collection, 0, len(g.Geometries))
+		for _, geometry := range g.Geometries {
+			member, err := NewGeometryFromGeoJSON(geometry)
+			if err != nil {
+				return nil, err
+			}
+			c = append(c, member)
+		}
+		return c, nil
+	}
+
+	return nil, ErrIncorrectGeometry
+}
+
+// newPolygonFromXYSlices creates a polygon from GeoJSON rings, exterior first.
+func newPolygonFromXYSlices(rings [][][]float64) *Polygon {
+	if len(rings) == 0 {
+		return NewPolygon(NewPath())
+	}
+
+	p := NewPolygon(NewPathFromXYSlice(rings[0]))
+	for _, ring := range rings[1:] {
+		p.AddHole(NewPathFromXYSlice(ring))
+	}
+
+	return p
+}
+
+// Bound returns a zero size bound around the point.
+func (p *Point) Bound() *Bound {
+	return NewBound(p[0], p[0], p[1], p[1])
+}
+
+// DistanceFrom returns zero for points inside the polygon, or on one of its rings,
+// otherwise the distance to the closest ring. Assumes a planar projection.
+func (p *Polygon) DistanceFrom(point *Point) float64 {
+	if p.Contains(point) {
+		return 0
+	}
+
+	dist := math.Inf(1)
+	for _, ring := range p.rings() {
+		dist = math.Min(dist, (&Path{ring}).DistanceFrom(point))
+	}
+
+	return dist
+}
+
+// Bound returns a bound around the points.
+// Empty sets have an empty bound at the origin.
+func (mp MultiPoint) Bound() *Bound {
+	return PointSet(mp).Bound()
+}
+
+// DistanceFrom returns the distance to the closest point, or +Inf if there are none.
+func (mp MultiPoint) DistanceFrom(point *Point) float64 {
+	dist, _ := PointSet(mp).DistanceFrom(point)
+	return dist
+}
+
+// ToGeoJSON creates a new geojson feature with a multipoint geometry.
+func (mp MultiPoint) ToGeoJSON() *geojson.Feature {
+	return PointSet(mp).ToGeoJSON()
+}
+
+// ToWKT returns the points in WKT format, eg. MULTIPOINT(30 10,10 30,40 40).
+// Empty sets will be 'MULTIPOINT EMPTY'.
+func (mp MultiPoint) ToWKT() string {
+	if len(mp) == 0 {
+		return "MULTIPOINT EMPTY"
+	}
+
+	return PointSet(mp).String()
+}
+
+// String returns a string representation of the points.
+// The format is WKT, see ToWKT.
+func (mp MultiPoint) String() string {
+	return mp.ToWKT()
+}
+
+// Bound returns a bound around all the paths.
+// Empty sets have an empty bound at the origin.
+func (mp MultiPath) Bound() *Bound {
+	bounds := make([]*Bound, len(mp))
+	for i, p := range mp {
+		bounds[i] = p.Bound()
+	}
+
+	return geometryBound(bounds)
+}
+
+// DistanceFrom returns the distance to the closest path, or +Inf if there are none.
+func (mp MultiPath) DistanceFrom(point *Point) float64 {
+	dist := math.Inf(1)
+	for _, p := range mp {
+		dist = math.Min(dist, p.DistanceFrom(point))
+	}
+
+	return dist
+}
+
+// ToGeoJSON creates a new geojson feature with a multilinestring geometry.
+func (mp MultiPath) ToGeoJSON() *geojson.Feature {
+	lines := make([][][]float64, 0, len(mp))
+	for _, p := range mp {
+		lines = append(lines, p.ToGeoJSONGeometry().LineString)
+	}
+
+	return geojson.NewMultiLineStringFeature(lines...)
+}
+
+// ToWKT returns the paths in WKT format, eg. MULTILINESTRING((10 10,20 20),(40 40,30 30)).
+// Empty sets will be 'MULTILINESTRING EMPTY' and empty paths EMPTY, eg. MULTILINESTRING(EMPTY,(1 2,3 4)).
+func (mp MultiPath) ToWKT() string {
+	if len(mp) == 0 {
+		return "MULTILINESTRING EMPTY"
+	}
+
+	buff := bytes.NewBuffer(nil)
+	buff.WriteString("MULTILINESTRING(")
+
+	for i, p := range mp {
+		if i != 0 {
+			buff.WriteByte(',')
+		}
+
+		if len(p.PointSet) == 0 {
+			buff.WriteString("EMPTY")
+			continue
+		}
+
+		writeWKTPoints(buff, p.PointSet)
+	}
+
+	buff.WriteByte(')')
+	return buff.String()
+}
+
+// String returns a string representation of the paths.
+// The format is WKT, see ToWKT.
+func (mp MultiPath) String() string {
+	return mp.ToWKT()
+}
+
+// Bound returns a bound around all the polygons.
+// Empty sets have an empty bound at the origin.
+func (mp MultiPolygon) Bound() *Bound {
+	bounds := make([]*Bound, len(mp))
+	for i, p := range mp {
+		bounds[i] = p.Bound()
+	}
+
+	return geometryBound(bounds)
+}
+
+// DistanceFrom returns the distance to the closest polygon, zero if inside one,
+// or +Inf if there are none.
+func (mp MultiPolygon) DistanceFrom(point *Point) float64 {
+	dist := math.Inf(1)
+	for _, p := range mp {
+		dist = math.Min(dist, p.DistanceFrom(point))
+	}
+
+	return dist
+}
+
+// ToGeoJSON creates a new geojson feature with a multipolygon geometry.
+// The rings are wound as required by RFC 7946, see Polygon.ToGeoJSONGeometry.
+func (mp MultiPolygon) ToGeoJSON() *geojson.Feature {
+	polygons := make([][][][]float64, 0, len(mp))
+	for _, p := range mp {
+		polygons = append(polygons, p.ToGeoJSONGeometry().Polygon)
+	}
+
+	return geojson.NewMultiPolygonFeature(polygons...)
+}
+
+// ToWKT returns the polygons in WKT format, eg. MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2))).
+// Empty sets will be 'MULTIPOLYGON EMPTY', empty polygons are skipped.
+func (mp MultiPolygon) ToWKT() string {
+	buff := bytes.NewBuffer(nil)
+	buff.WriteString("MULTIPOLYGON(")
+
+	count := 0
+	for _, p := range mp {
+		rings := p.rings()
+		if len(rings) == 0 {
+			continue
+		}
+
+		if count != 0 {
+			buff.WriteByte(',')
+		}
+
+		writeWKTRings(buff, rings)
+		count++
+	}
+
+	if count == 0 {
+		return "MULTIPOLYGON EMPTY"
+	}
+
+	buff.WriteByte(')')
+	return buff.String()
+}
+
+// String returns a string representation of the polygons.
+// The format is WKT, see ToWKT.
+func (mp MultiPolygon) String() string {
+	return mp.ToWKT()
+}
+
+// Bound returns a bound around all the geometries.
+// Empty collections have an empty bound at the origin.
+func (c Collection) Bound() *Bound {
+	bounds := make([]*Bound, len(c))
+	for i, g := range c {
+		bounds[i] = g.Bound()
+	}
+
+	return geometryBound(bounds)
+}
+
+// DistanceFrom returns the distance to the closest geometry, or +Inf if there are none.
+func (c Collection) DistanceFrom(point *Point) float64 {
+	dist := math.Inf(1)
+	for _, g := range c {
+		dist = math.Min(dist, g.DistanceFrom(point))
+	}
+
+	return dist
+}
+
+// ToGeoJSON creates a new geojson feature with a geometry collection
+// of the geometries of the members.
+func (c Collection) ToGeoJSON() *geojson.Feature {
+	geometries := make([]*geojson.Geometry, 0, len(c))
+	for _, g := range c {
+		geometries = append(geometries, g.ToGeoJSON().Geometry)
+	}
+
+	return geojson.NewCollectionFeature(geometries...)
+}
+
+// ToWKT returns the geometries in WKT format, eg. GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10)).
+// Empty collections will be 'GEOMETRYCOLLECTION EMPTY' and empty members are
+// typed, eg. GEOMETRYCOLLECTION(LINESTRING EMPTY,POINT(4 6)).
+func (c Collection) ToWKT() string {
+	if len(c) == 0 {
+		return "GEOMETRYCOLLECTION EMPTY"
+	}
+
+	buff := bytes.NewBuffer(nil)
+	buff.WriteString("GEOMETRYCOLLECTION(")
+
+	for i, g := range c {
+		if i != 0 {
+			buff.WriteByte(',')
+		}
+
+		buff.WriteString(g.ToWKT())
+	}
+
+	buff.WriteByte(')')
+	return buff.String()
+}
+
+// String returns a string representation of the geometries.
+// The format is WKT, see ToWKT.
+func (c Collection) String() string {
+	return c.ToWKT()
+}
+
+// geometryBound returns the union of the bounds, or an empty bound at the origin.
+func geometryBound(bounds []*Bound) *Bound {
+	if len(bounds) == 0 {
+		return NewBound(0, 0, 0, 0)
+	}
+
+	b := bounds[0].Clone()
+	for _, bound := range bounds[1:] {
+		b.Union(bound)
+	}
+
+	return b
+}
+
+// writeWKTPoints writes the points as a WKT point list, eg. (30 10,10 30,40 40).
+func writeWKTPoints(buff *bytes.Buffer, points PointSet) {
+	buff.WriteByte('(')
+	for i, point := range points {
+		if i != 0 {
+			buff.WriteByte(',')
+		}
+
+		fmt.Fprintf(buff, "%g %g", point[0], point[1])
+	}
+	buff.WriteByte(')')
+}
+
+// writeWKTRings writes the rings as a WKT polygon, eg. ((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1)).
+func writeWKTRings(buff *bytes.Buffer, rings []PointSet) {
+	buff.WriteByte('(')
+	for i, ring := range rings {
+		if i != 0 {
+			buff.WriteByte(',')
+		}
+
+		writeWKTPoints(buff, ring)
+	}
+	buff.WriteByte(')')
+}
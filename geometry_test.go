@@ -0,0 +1,252 @@
+package geo
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/paulmach/go.geojson"
+)
+
+// interface checks
+var (
+	_ Geometry = &Point{}
+	_ Geometry = &Line{}
+	_ Geometry = &Path{}
+	_ Geometry = &Polygon{}
+	_ Geometry = MultiPoint{}
+	_ Geometry = MultiPath{}
+	_ Geometry = MultiPolygon{}
+	_ Geometry = Collection{}
+)
+
+func TestNewGeometryFromGeoJSONFixture(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/geometries.geojson")
+	if err != nil {
+		t.Fatalf("unable to open fixture: %v", err)
+	}
+
+	fc, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("geometry, unmarshal error: %v", err)
+	}
+
+	expected := map[string]string{
+		"point":              "POINT(-122.4 37.8)",
+		"multipoint":         "MULTIPOINT(-122.4 37.8,-122.5 37.7)",
+		"linestring":         "LINESTRING(-122.4 37.8,-122.5 37.7,-122.6 37.8)",
+		"multilinestring":    "MULTILINESTRING((-122.4 37.8,-122.5 37.7),(-122.3 37.9,-122.2 38,-122.1 37.9))",
+		"polygon":            "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,1 2,2 2,2 1,1 1))",
+		"multipolygon":       "MULTIPOLYGON(((0 0,1 0,1 1,0 1,0 0)),((2 2,3 2,3 3,2 3,2 2)))",
+		"geometrycollection": "GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10),GEOMETRYCOLLECTION(POINT(1 1)))",
+	}
+
+	if len(fc.Features) != len(expected) {
+		t.Fatalf("geometry, expected %d features, got %d", len(expected), len(fc.Features))
+	}
+
+	for _, f := range fc.Features {
+		name := f.Properties["name"].(string)
+
+		g, err := NewGeometryFromGeoJSON(f.Geometry)
+		if err != nil {
+			t.Errorf("geometry, %s decode error: %v", name, err)
+			continue
+		}
+
+		if wkt := g.ToWKT(); wkt != expected[name] {
+			t.Errorf("geometry, %s expected %s, got %s", name, expected[name], wkt)
+		}
+
+		// encodes back to the same type
+		if typ := g.ToGeoJSON().Geometry.Type; typ != f.Geometry.Type {
+			t.Errorf("geometry, %s geojson type expected %s, got %s", name, f.Geometry.Type, typ)
+		}
+
+		// and the wkt decodes to the same
+		g2, err := NewGeometryFromWKT(g.ToWKT())
+		if err != nil {
+			t.Errorf("geometry, %s wkt decode error: %v", name, err)
+		} else if g2.ToWKT() != g.ToWKT() {
+			t.Errorf("geometry, %s wkt round trip expected %s, got %s", name, g.ToWKT(), g2.ToWKT())
+		}
+	}
+}
+
+func TestNewGeometryFromGeoJSON(t *testing.T) {
+	g, err := NewGeometryFromGeoJSON(geojson.NewPointGeometry([]float64{1, 2}))
+	if p, ok := g.(*Point); err != nil || !ok || !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("geometry, point incorrect, got %v %v", g, err)
+	}
+
+	g, err = NewGeometryFromGeoJSON(geojson.NewMultiLineStringGeometry([][]float64{{1, 2}, {3, 4}}))
+	if mp, ok := g.(MultiPath); err != nil || !ok || len(mp) != 1 || mp[0].Length() != 2 {
+		t.Errorf("geometry, multilinestring incorrect, got %v %v", g, err)
+	}
+
+	g, err = NewGeometryFromGeoJSON(geojson.NewMultiPolygonGeometry())
+	if mp, ok := g.(MultiPolygon); err != nil || !ok || len(mp) != 0 {
+		t.Errorf("geometry, empty multipolygon incorrect, got %v %v", g, err)
+	}
+
+	errorTests := []*geojson.Geometry{
+		nil,
+		geojson.NewPointGeometry([]float64{1}),
+		{Type: "Unknown"},
+		geojson.NewCollectionGeometry(geojson.NewPointGeometry(nil)),
+	}
+
+	for i, g := range errorTests {
+		if _, err := NewGeometryFromGeoJSON(g); err != ErrIncorrectGeometry {
+			t.Errorf("geometry, %d expected incorrect geometry error, got %v", i, err)
+		}
+	}
+}
+
+func TestGeometryBound(t *testing.T) {
+	if b := NewPoint(1, 2).Bound(); !b.Equals(NewBound(1, 1, 2, 2)) {
+		t.Errorf("geometry, point bound incorrect, got %v", b)
+	}
+
+	if b := (MultiPoint{{1, 2}, {-1, 5}}).Bound(); !b.Equals(NewBound(-1, 1, 2, 5)) {
+		t.Errorf("geometry, multipoint bound incorrect, got %v", b)
+	}
+
+	mp := MultiPath{
+		NewPathFromXYData([][2]float64{{0, 0}, {1, 1}}),
+		NewPathFromXYData([][2]float64{{5, -1}, {6, 0}}),
+	}
+	if b := mp.Bound(); !b.Equals(NewBound(0, 6, -1, 1)) {
+		t.Errorf("geometry, multipath bound incorrect, got %v", b)
+	}
+
+	c := Collection{NewPoint(10, 10), mp, MultiPolygon{testRectangle(-3, -2, 0, 1)}}
+	if b := c.Bound(); !b.Equals(NewBound(-3, 10, -1, 10)) {
+		t.Errorf("geometry, collection bound incorrect, got %v", b)
+	}
+
+	// not modified by the union
+	if b := mp[0].Bound(); !b.Equals(NewBound(0, 1, 0, 1)) {
+		t.Errorf("geometry, member bound should not change, got %v", b)
+	}
+
+	for _, g := range []Geometry{MultiPoint{}, MultiPath{}, MultiPolygon{}, Collection{}} {
+		if b := g.Bound(); !b.Equals(NewBound(0, 0, 0, 0)) {
+			t.Errorf("geometry, empty bound should be at the origin, got %v", b)
+		}
+	}
+}
+
+func TestGeometryDistanceFrom(t *testing.T) {
+	point := NewPoint(0, 0)
+
+	cases := []struct {
+		geometry Geometry
+		distance float64
+	}{
+		{NewPoint(3, 4), 5},
+		{MultiPoint{{3, 4}, {0, 2}, {10, 0}}, 2},
+		{MultiPath{
+			NewPathFromXYData([][2]float64{{3, -1}, {3, 1}}),
+			NewPathFromXYData([][2]float64{{-2, -1}, {-2, 1}}),
+		}, 2},
+		{testRectangle(-1, 1, -1, 1), 0},
+		{testRectangle(1, 2, -1, 1), 1},
+		{testRectangle(-4, 4, -4, 4).AddHole(testRectangle(-1, 1, -1, 2).Exterior()), 1},
+		{MultiPolygon{testRectangle(3, 4, 0, 1), testRectangle(-2, -1, 0, 1)}, 1},
+		{Collection{NewPoint(0, 3), MultiPoint{{0, 4}}, Collection{NewPoint(0.5, 0)}}, 0.5},
+		{MultiPoint{}, math.Inf(1)},
+		{Collection{}, math.Inf(1)},
+	}
+
+	for i, tc := range cases {
+		if d := tc.geometry.DistanceFrom(point); d != tc.distance {
+			t.Errorf("geometry, %d distance expected %v, got %v", i, tc.distance, d)
+		}
+	}
+}
+
+func TestGeometryToGeoJSON(t *testing.T) {
+	f := MultiPoint{{1, 2}, {3, 4}}.ToGeoJSON()
+	if !f.Geometry.IsMultiPoint() || len(f.Geometry.MultiPoint) != 2 {
+		t.Errorf("geometry, multipoint geojson incorrect, got %v", f.Geometry)
+	}
+
+	f = MultiPath{NewPathFromXYData([][2]float64{{1, 2}, {3, 4}})}.ToGeoJSON()
+	if !f.Geometry.IsMultiLineString() || len(f.Geometry.MultiLineString) != 1 || len(f.Geometry.MultiLineString[0]) != 2 {
+		t.Errorf("geometry, multipath geojson incorrect, got %v", f.Geometry)
+	}
+
+	// rings normalized for RFC 7946
+	clockwise := NewPolygon(testRectangle(0, 1, 0, 1).Exterior().Reverse())
+
+	f = MultiPolygon{clockwise}.ToGeoJSON()
+	if !f.Geometry.IsMultiPolygon() || len(f.Geometry.MultiPolygon) != 1 {
+		t.Fatalf("geometry, multipolygon geojson incorrect, got %v", f.Geometry)
+	}
+
+	if ring := NewPathFromXYSlice(f.Geometry.MultiPolygon[0][0]); ring.IsClockwise() {
+		t.Errorf("geometry, multipolygon geojson should be counter clockwise")
+	}
+
+	f = Collection{NewPoint(1, 2), clockwise}.ToGeoJSON()
+	if !f.Geometry.IsCollection() || len(f.Geometry.Geometries) != 2 ||
+		!f.Geometry.Geometries[0].IsPoint() || !f.Geometry.Geometries[1].IsPolygon() {
+		t.Errorf("geometry, collection geojson incorrect, got %v", f.Geometry)
+	}
+}
+
+func TestGeometryToWKT(t *testing.T) {
+	cases := []struct {
+		geometry Geometry
+		wkt      string
+	}{
+		{MultiPoint{}, "MULTIPOINT EMPTY"},
+		{MultiPath{}, "MULTILINESTRING EMPTY"},
+		{MultiPolygon{}, "MULTIPOLYGON EMPTY"},
+		{MultiPolygon{NewPolygon(NewPath())}, "MULTIPOLYGON EMPTY"},
+		{Collection{}, "GEOMETRYCOLLECTION EMPTY"},
+		{MultiPoint{{1, 2}, {3, 4}}, "MULTIPOINT(1 2,3 4)"},
+		{MultiPath{NewPathFromXYData([][2]float64{{1, 2}, {3, 4}})}, "MULTILINESTRING((1 2,3 4))"},
+		{MultiPath{NewPath(), NewPathFromXYData([][2]float64{{1, 2}, {3, 4}})}, "MULTILINESTRING(EMPTY,(1 2,3 4))"},
+		{MultiPolygon{NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {1, 1}}))}, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))"},
+		{Collection{NewPoint(4, 6), MultiPoint{{1, 2}}}, "GEOMETRYCOLLECTION(POINT(4 6),MULTIPOINT(1 2))"},
+		{Collection{NewPath(), NewPolygon(NewPath())}, "GEOMETRYCOLLECTION(LINESTRING EMPTY,POLYGON EMPTY)"},
+	}
+
+	for _, tc := range cases {
+		if wkt := tc.geometry.ToWKT(); wkt != tc.wkt {
+			t.Errorf("geometry, wkt expected %s, got %s", tc.wkt, wkt)
+		}
+	}
+
+	if s := (MultiPoint{{1, 2}}).String(); s != "MULTIPOINT(1 2)" {
+		t.Errorf("geometry, string should be wkt, got %s", s)
+	}
+}
+
+func TestCollectionWKTRoundTrip(t *testing.T) {
+	c := Collection{
+		NewPath(),
+		NewPathFromXYData([][2]float64{{1, 2}, {3, 4}}),
+		MultiPath{NewPath()},
+		MultiPoint{},
+		NewPolygon(NewPath()),
+		NewPoint(4, 6),
+		Collection{NewPath()},
+	}
+
+	g, err := NewGeometryFromWKT(c.ToWKT())
+	if err != nil {
+		t.Fatalf("geometry, collection wkt should parse: %v", err)
+	}
+
+	if wkt := g.ToWKT(); wkt != c.ToWKT() {
+		t.Errorf("geometry, collection wkt round trip expected %s, got %s", c.ToWKT(), wkt)
+	}
+
+	decoded := g.(Collection)
+	if p, ok := decoded[0].(*Path); !ok || len(p.PointSet) != 0 {
+		t.Errorf("geometry, collection empty path should round trip, got %v", decoded[0])
+	}
+}
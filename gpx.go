@@ -0,0 +1,162 @@
+package geo
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GPXOptions are the options used by Path.ToGPXWithOptions.
+type GPXOptions struct {
+	// Name is set as the name of the track or route, if not empty.
+	Name string
+
+	// Creator is set as the creator attribute of the document,
+	// defaults to go.geo.
+	Creator string
+
+	// AsRoute outputs a route, with rtept elements,
+	// instead of a track with a single segment.
+	AsRoute bool
+}
+
+type gpxDocument struct {
+	XMLName xml.Name   `xml:"gpx"`
+	Version string     `xml:"version,attr"`
+	Creator string     `xml:"creator,attr"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	Routes  []gpxRoute `xml:"rte"`
+	Tracks  []gpxTrack `xml:"trk"`
+}
+
+type gpxRoute struct {
+	Name   string     `xml:"name,omitempty"`
+	Points []gpxPoint `xml:"rtept"`
+}
+
+type gpxTrack struct {
+	Name     string       `xml:"name,omitempty"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat string `xml:"lat,attr"`
+	Lon string `xml:"lon,attr"`
+}
+
+// NewPathFromGPX creates a path from a GPX document by concatenating the points
+// of all track segments followed by the points of all routes.
+// Waypoints, elevation, time and other elements are ignored.
+// Use NewPathsFromGPX to get a path per track segment and route.
+func NewPathFromGPX(r io.Reader) (*Path, error) {
+	paths, err := NewPathsFromGPX(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewPath()
+	for _, path := range paths {
+		p.PointSet = append(p.PointSet, path.PointSet...)
+	}
+
+	return p, nil
+}
+
+// NewPathsFromGPX creates a path for every track segment, followed by
+// a path for every route, in a GPX document.
+// Waypoints, elevation, time and other elements are ignored.
+func NewPathsFromGPX(r io.Reader) ([]*Path, error) {
+	doc := &gpxDocument{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+
+	var paths []*Path
+	for _, track := range doc.Tracks {
+		for _, segment := range track.Segments {
+			p, err := newPathFromGPXPoints(segment.Points)
+			if err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, p)
+		}
+	}
+
+	for _, route := range doc.Routes {
+		p, err := newPathFromGPXPoints(route.Points)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, p)
+	}
+
+	return paths, nil
+}
+
+func newPathFromGPXPoints(points []gpxPoint) (*Path, error) {
+	p := NewPathPreallocate(0, len(points))
+	for _, point := range points {
+		lat, err := strconv.ParseFloat(point.Lat, 64)
+		if err != nil {
+			return nil, fmt.Errorf("geo: invalid gpx latitude %q", point.Lat)
+		}
+
+		lon, err := strconv.ParseFloat(point.Lon, 64)
+		if err != nil {
+			return nil, fmt.Errorf("geo: invalid gpx longitude %q", point.Lon)
+		}
+
+		p.PointSet = append(p.PointSet, Point{lon, lat})
+	}
+
+	return p, nil
+}
+
+// ToGPX writes the path, in lng/lat, as a GPX 1.1 document with a single track segment.
+func (p *Path) ToGPX(w io.Writer) error {
+	return p.ToGPXWithOptions(w, GPXOptions{})
+}
+
+// ToGPXWithOptions writes the path, in lng/lat, as a GPX 1.1 document
+// using the given options.
+func (p *Path) ToGPXWithOptions(w io.Writer, opts GPXOptions) error {
+	doc := &gpxDocument{
+		Version: "1.1",
+		Creator: opts.Creator,
+		Xmlns:   "http://www.topografix.com/GPX/1/1",
+	}
+
+	if doc.Creator == "" {
+		doc.Creator = "go.geo"
+	}
+
+	points := make([]gpxPoint, 0, len(p.PointSet))
+	for _, point := range p.PointSet {
+		points = append(points, gpxPoint{
+			Lat: strconv.FormatFloat(point.Lat(), 'f', -1, 64),
+			Lon: strconv.FormatFloat(point.Lng(), 'f', -1, 64),
+		})
+	}
+
+	if opts.AsRoute {
+		doc.Routes = []gpxRoute{{Name: opts.Name, Points: points}}
+	} else {
+		doc.Tracks = []gpxTrack{{Name: opts.Name, Segments: []gpxSegment{{Points: points}}}}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+
+	return e.Encode(doc)
+}
@@ -0,0 +1,155 @@
+package geo
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewPathFromGPX(t *testing.T) {
+	f, err := os.Open("testdata/track.gpx")
+	if err != nil {
+		t.Fatalf("unable to open fixture: %v", err)
+	}
+	defer f.Close()
+
+	p, err := NewPathFromGPX(f)
+	if err != nil {
+		t.Fatalf("gpx, parse error: %v", err)
+	}
+
+	if l := p.Length(); l != 7 {
+		t.Fatalf("gpx, expected 7 points, got %d", l)
+	}
+
+	if point := p.GetAt(0); !point.Equals(NewPoint(-122.41942, 37.77493)) {
+		t.Errorf("gpx, first point incorrect, got %v", point)
+	}
+
+	// first point of second segment
+	if point := p.GetAt(3); !point.Equals(NewPoint(-122.46, 37.801)) {
+		t.Errorf("gpx, fourth point incorrect, got %v", point)
+	}
+}
+
+func TestNewPathsFromGPX(t *testing.T) {
+	f, err := os.Open("testdata/track.gpx")
+	if err != nil {
+		t.Fatalf("unable to open fixture: %v", err)
+	}
+	defer f.Close()
+
+	paths, err := NewPathsFromGPX(f)
+	if err != nil {
+		t.Fatalf("gpx, parse error: %v", err)
+	}
+
+	if len(paths) != 3 {
+		t.Fatalf("gpx, expected 2 segments and 1 route, got %d paths", len(paths))
+	}
+
+	for i, l := range []int{3, 2, 2} {
+		if paths[i].Length() != l {
+			t.Errorf("gpx, path %d should have %d points, got %d", i, l, paths[i].Length())
+		}
+	}
+
+	if point := paths[2].GetAt(1); !point.Equals(NewPoint(-122.41942, 37.77493)) {
+		t.Errorf("gpx, route point incorrect, got %v", point)
+	}
+}
+
+func TestNewPathFromGPXErrors(t *testing.T) {
+	errorTests := []string{
+		``,
+		`<gpx><trk><trkseg><trkpt lat="1" lon="2"></trkseg></trk></gpx>`,
+		`<kml></kml>`,
+		`<gpx><trk><trkseg><trkpt lat="abc" lon="2"/></trkseg></trk></gpx>`,
+		`<gpx><rte><rtept lat="1"/></rte></gpx>`,
+	}
+
+	for i, test := range errorTests {
+		if _, err := NewPathFromGPX(strings.NewReader(test)); err == nil {
+			t.Errorf("gpx, test %d should return error", i)
+		}
+	}
+
+	p, err := NewPathFromGPX(strings.NewReader(`<gpx version="1.1"></gpx>`))
+	if err != nil || p.Length() != 0 {
+		t.Errorf("gpx, document without tracks should be an empty path, got %v %v", p, err)
+	}
+}
+
+func TestPathToGPX(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551)).
+		Push(NewPoint(0.0000001, -0.0000001))
+
+	buf := &bytes.Buffer{}
+	if err := p.ToGPX(buf); err != nil {
+		t.Fatalf("gpx, write error: %v", err)
+	}
+
+	s := buf.String()
+	expected := []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<gpx version="1.1" creator="go.geo" xmlns="http://www.topografix.com/GPX/1/1">`,
+		`<trkpt lat="37.77493" lon="-122.41942"></trkpt>`,
+		`<trkpt lat="-0.0000001" lon="0.0000001"></trkpt>`,
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(s, e) {
+			t.Errorf("gpx, output should contain %s, got %s", e, s)
+		}
+	}
+
+	if strings.Contains(s, "<rte>") || strings.Contains(s, "<name>") {
+		t.Errorf("gpx, output should only have an unnamed track, got %s", s)
+	}
+
+	decoded, err := NewPathFromGPX(buf)
+	if err != nil {
+		t.Fatalf("gpx, parse error: %v", err)
+	}
+
+	if !decoded.Equals(p) {
+		t.Errorf("gpx, round trip incorrect, got %v", decoded)
+	}
+}
+
+func TestPathToGPXWithOptions(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(1, 2)).
+		Push(NewPoint(3, 4))
+
+	buf := &bytes.Buffer{}
+	err := p.ToGPXWithOptions(buf, GPXOptions{Name: "Tom & Jerry's <route>", Creator: "tests", AsRoute: true})
+	if err != nil {
+		t.Fatalf("gpx, write error: %v", err)
+	}
+
+	s := buf.String()
+	expected := []string{
+		`creator="tests"`,
+		`<name>Tom &amp; Jerry&#39;s &lt;route&gt;</name>`,
+		`<rtept lat="2" lon="1"></rtept>`,
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(s, e) {
+			t.Errorf("gpx, output should contain %s, got %s", e, s)
+		}
+	}
+
+	if strings.Contains(s, "<trk>") {
+		t.Errorf("gpx, output should not contain a track, got %s", s)
+	}
+
+	paths, err := NewPathsFromGPX(buf)
+	if err != nil || len(paths) != 1 || !paths[0].Equals(p) {
+		t.Errorf("gpx, route round trip incorrect, got %v %v", paths, err)
+	}
+}
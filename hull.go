@@ -0,0 +1,250 @@
+package geo
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	// ErrDegenerateHull is returned when the points do not enclose an area,
+	// ie. there are fewer than 3 unique points or they are all collinear.
+	ErrDegenerateHull = errors.New("go.geo: points do not enclose an area")
+
+	// ErrConcaveHullK is returned when the concave hull k is less than 3.
+	ErrConcaveHullK = errors.New("go.geo: concave hull k must be at least 3")
+)
+
+// ConvexHull returns the convex hull of the points as a closed, counter clockwise, ring
+// starting at the leftmost, then lowest, point. Points along the edges are not included.
+// Collinear points give a zero area ring between the two extremes, eg. [a, b, a].
+// Uses Andrew's monotone chain algorithm. Assumes a planar projection.
+func (ps PointSet) ConvexHull() *Path {
+	points := hullUniquePoints(ps)
+	if len(points) < 3 {
+		return NewPath().SetPoints(points).Close()
+	}
+
+	return hullMonotoneChain(points)
+}
+
+// hullMonotoneChain returns the closed convex hull of the sorted, unique, points.
+func hullMonotoneChain(points PointSet) *Path {
+	hull := make(PointSet, 0, 2*len(points))
+
+	for _, p := range points {
+		for len(hull) >= 2 && hullCross(&hull[len(hull)-2], &hull[len(hull)-1], &p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	lower := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		p := points[i]
+		for len(hull) >= lower && hullCross(&hull[len(hull)-2], &hull[len(hull)-1], &p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// the last point is the first, so the ring is closed
+	return NewPath().SetPoints(hull)
+}
+
+// ConcaveHull returns a concave hull of the points, a closed counter clockwise ring
+// with all the points inside or on it, using the k-nearest neighbors algorithm of
+// Moreira and Santos. Each step of the walk around the points only considers the k
+// nearest points, so smaller values of k give tighter, more concave, hulls. If the walk
+// fails, by intersecting itself or leaving points outside, k is increased until it
+// succeeds, falling back to the convex hull. Duplicate points are ignored.
+// Returns ErrDegenerateHull if the points do not enclose an area and
+// ErrConcaveHullK if k is less than 3. Assumes a planar projection.
+func (ps PointSet) ConcaveHull(k int) (*Path, error) {
+	if k < 3 {
+		return nil, ErrConcaveHullK
+	}
+
+	points := hullUniquePoints(ps)
+	if len(points) < 3 {
+		return nil, ErrDegenerateHull
+	}
+
+	convex := hullMonotoneChain(points)
+	if convex.Area() == 0 {
+		return nil, ErrDegenerateHull
+	}
+
+	// with all the other points as neighbors the walk is the convex hull
+	for ; k < len(points)-1; k++ {
+		if hull := hullConcave(points, k); hull != nil {
+			return hull, nil
+		}
+	}
+
+	return convex, nil
+}
+
+// hullConcave walks around the unique points counter clockwise, from the lowest point,
+// each step taking the neighbor with the sharpest right turn that doesn't cross the
+// hull so far. Returns nil if the walk fails or leaves points outside the hull.
+func hullConcave(points PointSet, k int) *Path {
+	first := 0
+	for i, p := range points {
+		if p[1] < points[first][1] || (p[1] == points[first][1] && p[0] < points[first][0]) {
+			first = i
+		}
+	}
+
+	remaining := make([]int, 0, len(points))
+	for i := range points {
+		if i != first {
+			remaining = append(remaining, i)
+		}
+	}
+
+	hull := PointSet{points[first]}
+	current := points[first]
+	back := Point{-1, 0} // as if arriving from the west
+
+	for step := 0; ; step++ {
+		// allow closing the ring once there is a triangle
+		if step == 3 {
+			remaining = append(remaining, first)
+		}
+
+		if len(remaining) == 0 {
+			return nil
+		}
+
+		// the k nearest remaining points
+		candidates := &hullSorter{indexes: append([]int(nil), remaining...), keys: make([]float64, len(remaining))}
+		for i, index := range candidates.indexes {
+			candidates.keys[i] = current.SquaredDistanceFrom(&points[index])
+		}
+		sort.Stable(candidates)
+
+		if len(candidates.indexes) > k {
+			candidates.indexes = candidates.indexes[:k]
+			candidates.keys = candidates.keys[:k]
+		}
+
+		// by counter clockwise angle from the previous point, the first is the sharpest
+		// right turn. Ties keep the nearer point first, so collinear points are not skipped.
+		for i, index := range candidates.indexes {
+			v := points[index]
+			v.Subtract(&current)
+
+			angle := math.Atan2(back[0]*v[1]-back[1]*v[0], back[0]*v[0]+back[1]*v[1])
+			if angle <= 0 {
+				angle += 2 * math.Pi
+			}
+			candidates.keys[i] = angle
+		}
+		sort.Stable(candidates)
+
+		next := -1
+		for _, index := range candidates.indexes {
+			if !hullCrosses(hull, &points[index], index == first) {
+				next = index
+				break
+			}
+		}
+
+		if next == -1 {
+			return nil
+		}
+
+		hull = append(hull, points[next])
+		if next == first {
+			break
+		}
+
+		for i, index := range remaining {
+			if index == next {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+
+		back = current
+		back.Subtract(&points[next])
+		current = points[next]
+	}
+
+	path := NewPath().SetPoints(hull)
+	for i := range points {
+		if !path.ContainsWithBoundary(&points[i]) {
+			return nil
+		}
+	}
+
+	return path
+}
+
+// hullCrosses returns true if the edge from the last hull point to the next point
+// touches any of the non-adjacent hull edges. When closing, the first edge is adjacent.
+func hullCrosses(hull PointSet, next *Point, closing bool) bool {
+	edge := NewLine(&hull[len(hull)-1], next)
+
+	start := 0
+	if closing {
+		start = 1
+	}
+
+	for i := start; i < len(hull)-2; i++ {
+		if edge.Intersects(NewLine(&hull[i], &hull[i+1])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hullUniquePoints returns a copy of the points sorted by x, then y, without duplicates.
+func hullUniquePoints(ps PointSet) PointSet {
+	points := make(PointSet, len(ps))
+	copy(points, ps)
+	sort.Sort(pointSorter(points))
+
+	unique := points[:0]
+	for i, p := range points {
+		if i == 0 || p != unique[len(unique)-1] {
+			unique = append(unique, p)
+		}
+	}
+
+	return unique
+}
+
+// hullCross returns the cross product of o->a and o->b,
+// positive if o, a, b is a counter clockwise turn.
+func hullCross(o, a, b *Point) float64 {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
+
+// pointSorter sorts points by x, then y.
+type pointSorter PointSet
+
+func (s pointSorter) Len() int      { return len(s) }
+func (s pointSorter) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s pointSorter) Less(i, j int) bool {
+	if s[i][0] != s[j][0] {
+		return s[i][0] < s[j][0]
+	}
+
+	return s[i][1] < s[j][1]
+}
+
+// hullSorter sorts point indexes by the keys.
+type hullSorter struct {
+	indexes []int
+	keys    []float64
+}
+
+func (s *hullSorter) Len() int { return len(s.indexes) }
+func (s *hullSorter) Swap(i, j int) {
+	s.indexes[i], s.indexes[j] = s.indexes[j], s.indexes[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+func (s *hullSorter) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
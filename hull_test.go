@@ -0,0 +1,172 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPointSetConvexHull(t *testing.T) {
+	ps := PointSet{{0.5, 0.5}, {1, 1}, {0, 0}, {1, 0}, {0.5, 0}, {0, 1}, {0.2, 0.7}, {1, 0}}
+
+	hull := ps.ConvexHull()
+	expected := NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}})
+	if !hull.Equals(expected) {
+		t.Errorf("hull, convex expected %v, got %v", expected, hull)
+	}
+
+	if len(ps) != 8 || ps[0] != (Point{0.5, 0.5}) {
+		t.Errorf("hull, convex should not modify the points, got %v", ps)
+	}
+
+	// degenerate
+	cases := []struct {
+		points   PointSet
+		expected *Path
+	}{
+		{PointSet{{0, 0}, {2, 2}, {1, 1}}, NewPathFromXYData([][2]float64{{0, 0}, {2, 2}, {0, 0}})},
+		{PointSet{{1, 2}, {1, 2}}, NewPathFromXYData([][2]float64{{1, 2}, {1, 2}})},
+		{PointSet{}, NewPath()},
+	}
+
+	for _, tc := range cases {
+		if hull := tc.points.ConvexHull(); !hull.Equals(tc.expected) {
+			t.Errorf("hull, convex expected %v, got %v", tc.expected, hull)
+		}
+	}
+}
+
+func TestPointSetConcaveHull(t *testing.T) {
+	// a U shape, the convex hull covers the gap
+	var ps PointSet
+	for x := 0; x <= 10; x++ {
+		for y := 0; y <= 10; y++ {
+			if x >= 3 && x <= 7 && y >= 3 {
+				continue
+			}
+			ps = append(ps, Point{float64(x), float64(y)})
+		}
+	}
+
+	hull, err := ps.ConcaveHull(3)
+	if err != nil {
+		t.Fatalf("hull, concave error: %v", err)
+	}
+
+	checkConcaveHull(t, ps, hull)
+
+	if a, c := hull.Area(), ps.ConvexHull().Area(); a > 0.9*c {
+		t.Errorf("hull, concave should not cover the gap, area %v of %v", a, c)
+	}
+
+	if hull.Contains(NewPoint(5, 8)) {
+		t.Errorf("hull, concave should not contain the gap")
+	}
+}
+
+func TestPointSetConcaveHullRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 20; i++ {
+		n := 20 + r.Intn(200)
+
+		ps := make(PointSet, n)
+		for j := range ps {
+			if i%2 == 0 {
+				ps[j] = Point{r.Float64(), r.Float64()}
+			} else {
+				// clustered
+				ps[j] = Point{r.NormFloat64() + float64(j%3)*3, r.NormFloat64()}
+			}
+		}
+
+		for _, k := range []int{3, 5, 10} {
+			hull, err := ps.ConcaveHull(k)
+			if err != nil {
+				t.Fatalf("hull, concave %d error: %v", i, err)
+			}
+
+			checkConcaveHull(t, ps, hull)
+		}
+	}
+}
+
+func TestPointSetConcaveHullDegenerate(t *testing.T) {
+	// grid points are collinear in many directions, and duplicated
+	var ps PointSet
+	for x := 0; x < 6; x++ {
+		for y := 0; y < 6; y++ {
+			ps = append(ps, Point{float64(x), float64(y)}, Point{float64(x), float64(y)})
+		}
+	}
+
+	hull, err := ps.ConcaveHull(3)
+	if err != nil {
+		t.Fatalf("hull, concave error: %v", err)
+	}
+
+	checkConcaveHull(t, ps, hull)
+	if a := hull.Area(); a != 25 {
+		t.Errorf("hull, concave of a grid should be the square, got area %v", a)
+	}
+
+	// three points
+	hull, err = PointSet{{0, 0}, {1, 0}, {0, 1}}.ConcaveHull(3)
+	if err != nil || !hull.Equals(NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {0, 1}, {0, 0}})) {
+		t.Errorf("hull, concave of a triangle incorrect, got %v %v", hull, err)
+	}
+
+	// large k is the convex hull
+	ps = PointSet{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {2, 3.9}, {1, 1}}
+	hull, err = ps.ConcaveHull(100)
+	if err != nil || hull.Area() != ps.ConvexHull().Area() {
+		t.Errorf("hull, concave with large k should be convex, got %v %v", hull, err)
+	}
+
+	errorCases := []PointSet{
+		{},
+		{{1, 1}, {1, 1}, {1, 1}},
+		{{0, 0}, {1, 1}},
+		{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+	}
+
+	for _, ps := range errorCases {
+		if _, err := ps.ConcaveHull(3); err != ErrDegenerateHull {
+			t.Errorf("hull, concave of %v should be degenerate, got %v", ps, err)
+		}
+	}
+}
+
+func TestPointSetConcaveHullK(t *testing.T) {
+	for _, k := range []int{-1, 0, 2} {
+		hull, err := PointSet{{0, 0}, {1, 0}, {0, 1}}.ConcaveHull(k)
+		if hull != nil || err != ErrConcaveHullK {
+			t.Errorf("hull, concave with k = %d should be an error, got %v %v", k, hull, err)
+		}
+	}
+}
+
+func checkConcaveHull(t *testing.T, ps PointSet, hull *Path) {
+	if !hull.IsRing() || len(hull.PointSet) < 4 {
+		t.Errorf("hull, concave should be a closed ring, got %v", hull)
+		return
+	}
+
+	if hull.IsClockwise() {
+		t.Errorf("hull, concave should be counter clockwise")
+	}
+
+	if hull.SelfIntersects() {
+		t.Errorf("hull, concave should not intersect itself")
+	}
+
+	for i := range ps {
+		if !hull.ContainsWithBoundary(&ps[i]) {
+			t.Errorf("hull, concave should contain %v", ps[i])
+		}
+	}
+
+	if a, c := hull.Area(), ps.ConvexHull().Area(); a > c+1e-9*math.Abs(c) {
+		t.Errorf("hull, concave area %v should be at most the convex area %v", a, c)
+	}
+}
@@ -1,8 +1,11 @@
 package geo
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+
+	"github.com/paulmach/go.geojson"
 )
 
 // MarshalJSON enables lines to be encoded as JSON using the encoding/json package.
@@ -112,3 +115,59 @@ func (s *Surface) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// MarshalJSON enables features to be encoded as GeoJSON using the encoding/json package.
+func (f *Feature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.ToGeoJSON())
+}
+
+// UnmarshalJSON enables features to be decoded from GeoJSON using the encoding/json package.
+// Numbers in the id and properties are decoded as json.Number, instead of float64,
+// so integer values are not rounded and encode back exactly the same.
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	var feature geojson.Feature
+
+	err := unmarshalUseNumber(data, &feature)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := NewFeatureFromGeoJSON(&feature)
+	if err != nil {
+		return err
+	}
+
+	*f = *decoded
+	return nil
+}
+
+// MarshalJSON enables feature collections to be encoded as GeoJSON using the encoding/json package.
+func (fc *FeatureCollection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fc.ToGeoJSON())
+}
+
+// UnmarshalJSON enables feature collections to be decoded from GeoJSON using the
+// encoding/json package. Numbers are decoded as json.Number, see Feature.UnmarshalJSON.
+func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
+	var collection geojson.FeatureCollection
+
+	err := unmarshalUseNumber(data, &collection)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := NewFeatureCollectionFromGeoJSON(&collection)
+	if err != nil {
+		return err
+	}
+
+	*fc = *decoded
+	return nil
+}
+
+func unmarshalUseNumber(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
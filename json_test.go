@@ -2,6 +2,7 @@ package geo
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -177,3 +178,98 @@ func TestSurfaceJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestFeatureJSON(t *testing.T) {
+	f1 := NewFeature(NewPoint(1, 2))
+	f1.ID = int64(12345678901234567)
+	f1.SetProperty("name", "stop").
+		SetProperty("count", 3).
+		SetProperty("nested", map[string]interface{}{"list": []interface{}{1, "a", true}, "value": 1.5})
+
+	data, err := json.Marshal(f1)
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	if !strings.Contains(string(data), `"id":12345678901234567`) {
+		t.Errorf("json encoding id incorrect, got %v", string(data))
+	}
+
+	var f2 *Feature
+	err = json.Unmarshal(data, &f2)
+	if err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if p, ok := f2.Geometry.(*Point); !ok || !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("unmarshal geometry incorrect, got %v", f2.Geometry)
+	}
+
+	// integers are not rounded or changed to exponent notation
+	if id, ok := f2.ID.(json.Number); !ok || id.String() != "12345678901234567" {
+		t.Errorf("unmarshal id incorrect, got %v", f2.ID)
+	}
+
+	if c, ok := f2.Properties["count"].(json.Number); !ok || c.String() != "3" {
+		t.Errorf("unmarshal count incorrect, got %v", f2.Properties["count"])
+	}
+
+	nested, ok := f2.Properties["nested"].(map[string]interface{})
+	if !ok || nested["value"].(json.Number).String() != "1.5" || len(nested["list"].([]interface{})) != 3 {
+		t.Errorf("unmarshal nested property incorrect, got %v", f2.Properties["nested"])
+	}
+
+	// encodes back exactly the same
+	data2, err := json.Marshal(f2)
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	if string(data2) != string(data) {
+		t.Errorf("json round trip incorrect, expected %s, got %s", data, data2)
+	}
+
+	// invalid
+	if err := json.Unmarshal([]byte(`{"type":"Feature","properties":{}}`), &f2); err != ErrIncorrectGeometry {
+		t.Errorf("unmarshal without geometry should error, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":`), &f2); err == nil {
+		t.Errorf("unmarshal of invalid json should error")
+	}
+}
+
+func TestFeatureCollectionJSON(t *testing.T) {
+	fc1 := NewFeatureCollection(
+		NewFeature(NewPoint(1, 2)).SetProperty("id", 1),
+		NewFeature(NewPathFromXYData([][2]float64{{1, 2}, {3, 4}})).SetProperty("id", 2),
+	)
+
+	data, err := json.Marshal(fc1)
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	var fc2 *FeatureCollection
+	err = json.Unmarshal(data, &fc2)
+	if err != nil {
+		t.Fatalf("should unmarshal just fine, %v", err)
+	}
+
+	if fc2.Length() != 2 || fc2.Features[1].Properties["id"].(json.Number).String() != "2" {
+		t.Errorf("unmarshal incorrect, got %v", fc2.Features)
+	}
+
+	if _, ok := fc2.Features[1].Geometry.(*Path); !ok {
+		t.Errorf("unmarshal geometry should be a path, got %T", fc2.Features[1].Geometry)
+	}
+
+	data2, err := json.Marshal(fc2)
+	if err != nil {
+		t.Fatalf("should marshal just fine, %v", err)
+	}
+
+	if string(data2) != string(data) {
+		t.Errorf("json round trip incorrect, expected %s, got %s", data, data2)
+	}
+}
@@ -0,0 +1,82 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+)
+
+// KMLOptions are the options used by ToKMLWithOptions.
+type KMLOptions struct {
+	// Name and Description of the placemark, omitted if empty.
+	Name        string
+	Description string
+
+	// AltitudeMode of the geometry, one of clampToGround,
+	// relativeToGround or absolute. Omitted if empty.
+	AltitudeMode string
+}
+
+// ToKML returns the path as a KML 2.2 document with a single
+// LineString Placemark. The path must be in lng/lat.
+func (p *Path) ToKML() string {
+	return p.ToKMLWithOptions(KMLOptions{})
+}
+
+// ToKMLWithOptions returns the path as a KML 2.2 document with a single
+// LineString Placemark using the given options. The path must be in lng/lat.
+func (p *Path) ToKMLWithOptions(opts KMLOptions) string {
+	return kmlPlacemark("LineString", p.PointSet, opts)
+}
+
+// ToKML returns the point as a KML 2.2 document with a single
+// Point Placemark. The point must be in lng/lat.
+func (p Point) ToKML() string {
+	return p.ToKMLWithOptions(KMLOptions{})
+}
+
+// ToKMLWithOptions returns the point as a KML 2.2 document with a single
+// Point Placemark using the given options. The point must be in lng/lat.
+func (p Point) ToKMLWithOptions(opts KMLOptions) string {
+	return kmlPlacemark("Point", PointSet{p}, opts)
+}
+
+func kmlPlacemark(geometry string, ps PointSet, opts KMLOptions) string {
+	buf := bytes.NewBufferString(xml.Header)
+	buf.WriteString(`<kml xmlns="http://www.opengis.net/kml/2.2"><Placemark>`)
+
+	if opts.Name != "" {
+		buf.WriteString("<name>")
+		xml.EscapeText(buf, []byte(opts.Name))
+		buf.WriteString("</name>")
+	}
+
+	if opts.Description != "" {
+		buf.WriteString("<description>")
+		xml.EscapeText(buf, []byte(opts.Description))
+		buf.WriteString("</description>")
+	}
+
+	buf.WriteString("<" + geometry + ">")
+	if opts.AltitudeMode != "" {
+		buf.WriteString("<altitudeMode>")
+		xml.EscapeText(buf, []byte(opts.AltitudeMode))
+		buf.WriteString("</altitudeMode>")
+	}
+
+	// full precision, in lon,lat order, separated by spaces
+	buf.WriteString("<coordinates>")
+	for i, point := range ps {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+
+		buf.WriteString(strconv.FormatFloat(point[0], 'f', -1, 64))
+		buf.WriteByte(',')
+		buf.WriteString(strconv.FormatFloat(point[1], 'f', -1, 64))
+	}
+	buf.WriteString("</coordinates>")
+
+	buf.WriteString("</" + geometry + "></Placemark></kml>")
+	return buf.String()
+}
@@ -0,0 +1,108 @@
+package geo
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+// kmlDocument is the subset of the KML 2.2 schema used to validate the output.
+type kmlDocument struct {
+	XMLName   xml.Name `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Placemark struct {
+		Name        *string `xml:"name"`
+		Description *string `xml:"description"`
+		Point       *kmlGeometry
+		LineString  *kmlGeometry
+	}
+}
+
+type kmlGeometry struct {
+	AltitudeMode *string `xml:"altitudeMode"`
+	Coordinates  string  `xml:"coordinates"`
+}
+
+func TestPathToKML(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.4194155, 37.7749295)).
+		Push(NewPoint(-122.41866, 37.77551))
+
+	s := p.ToKML()
+	expected := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<kml xmlns="http://www.opengis.net/kml/2.2"><Placemark><LineString>` +
+		`<coordinates>-122.4194155,37.7749295 -122.41866,37.77551</coordinates>` +
+		`</LineString></Placemark></kml>`
+
+	if s != expected {
+		t.Errorf("kml, path incorrect, got %s", s)
+	}
+
+	doc := &kmlDocument{}
+	if err := xml.Unmarshal([]byte(s), doc); err != nil {
+		t.Fatalf("kml, path output should be valid: %v", err)
+	}
+
+	if doc.Placemark.LineString == nil || doc.Placemark.Point != nil || doc.Placemark.Name != nil {
+		t.Errorf("kml, path should only have a linestring, got %v", doc.Placemark)
+	}
+}
+
+func TestPathToKMLWithOptions(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(1, 2)).
+		Push(NewPoint(3, 4))
+
+	s := p.ToKMLWithOptions(KMLOptions{
+		Name:         "Tom & Jerry's <route>",
+		Description:  "a < b",
+		AltitudeMode: "clampToGround",
+	})
+
+	if !strings.Contains(s, "<name>Tom &amp; Jerry&#39;s &lt;route&gt;</name><description>a &lt; b</description>") {
+		t.Errorf("kml, name and description should be escaped, got %s", s)
+	}
+
+	doc := &kmlDocument{}
+	if err := xml.Unmarshal([]byte(s), doc); err != nil {
+		t.Fatalf("kml, path output should be valid: %v", err)
+	}
+
+	pm := doc.Placemark
+	if pm.Name == nil || *pm.Name != "Tom & Jerry's <route>" {
+		t.Errorf("kml, name incorrect, got %v", pm.Name)
+	}
+
+	if pm.Description == nil || *pm.Description != "a < b" {
+		t.Errorf("kml, description incorrect, got %v", pm.Description)
+	}
+
+	if pm.LineString == nil || pm.LineString.AltitudeMode == nil || *pm.LineString.AltitudeMode != "clampToGround" {
+		t.Errorf("kml, altitude mode incorrect, got %v", pm.LineString)
+	}
+
+	if pm.LineString.Coordinates != "1,2 3,4" {
+		t.Errorf("kml, coordinates incorrect, got %v", pm.LineString.Coordinates)
+	}
+}
+
+func TestPointToKML(t *testing.T) {
+	s := NewPoint(-122.4194155, 37.7749295).ToKMLWithOptions(KMLOptions{Name: "SF"})
+
+	doc := &kmlDocument{}
+	if err := xml.Unmarshal([]byte(s), doc); err != nil {
+		t.Fatalf("kml, point output should be valid: %v", err)
+	}
+
+	if doc.Placemark.Point == nil || doc.Placemark.LineString != nil {
+		t.Fatalf("kml, point should only have a point, got %s", s)
+	}
+
+	if c := doc.Placemark.Point.Coordinates; c != "-122.4194155,37.7749295" {
+		t.Errorf("kml, point coordinates incorrect, got %v", c)
+	}
+
+	// small values are not in exponent form
+	if s := NewPoint(1e-7, -1e-8).ToKML(); !strings.Contains(s, "<coordinates>0.0000001,-0.00000001</coordinates>") {
+		t.Errorf("kml, small coordinates incorrect, got %s", s)
+	}
+}
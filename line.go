@@ -139,6 +139,21 @@ func (l *Line) Measure(point *Point) float64 {
 	return l.Distance()
 }
 
+// ClosestPointTo returns the point on the line segment nearest the given point.
+// The result is one of the endpoints if the projection falls outside the segment.
+func (l *Line) ClosestPointTo(point *Point) *Point {
+	projFactor := l.Project(point)
+	if projFactor <= 0.0 {
+		return l.a.Clone()
+	}
+
+	if projFactor >= 1.0 {
+		return l.b.Clone()
+	}
+
+	return l.Interpolate(projFactor)
+}
+
 // Interpolate performs a simple linear interpolation, from A to B.
 // This function is the opposite of Project.
 func (l *Line) Interpolate(percent float64) *Point {
@@ -148,6 +163,33 @@ func (l *Line) Interpolate(percent float64) *Point {
 	}
 }
 
+// GeoInterpolate returns the point the given percent of the way, from A to B,
+// along the great circle path between the two points.
+// The line must be in lng/lat (EPSG:4326).
+func (l *Line) GeoInterpolate(percent float64) *Point {
+	aLngRad, aLatRad := deg2rad(l.a.Lng()), deg2rad(l.a.Lat())
+	bLngRad, bLatRad := deg2rad(l.b.Lng()), deg2rad(l.b.Lat())
+
+	// angular distance between the points
+	dist := l.a.GeoDistanceFrom(&l.b, true) / EarthRadius
+	if dist == 0 {
+		return l.a.Clone()
+	}
+
+	sinDist := math.Sin(dist)
+	a := math.Sin((1-percent)*dist) / sinDist
+	b := math.Sin(percent*dist) / sinDist
+
+	x := a*math.Cos(aLatRad)*math.Cos(aLngRad) + b*math.Cos(bLatRad)*math.Cos(bLngRad)
+	y := a*math.Cos(aLatRad)*math.Sin(aLngRad) + b*math.Cos(bLatRad)*math.Sin(bLngRad)
+	z := a*math.Sin(aLatRad) + b*math.Sin(bLatRad)
+
+	return &Point{
+		rad2deg(math.Atan2(y, x)),
+		rad2deg(math.Atan2(z, math.Sqrt(x*x+y*y))),
+	}
+}
+
 // Side returns 1 if the point is on the right side, -1 if on the left side, and 0 if collinear.
 func (l *Line) Side(p *Point) int {
 	val := (l.b[0]-l.a[0])*(p[1]-l.b[1]) - (l.b[1]-l.a[1])*(p[0]-l.b[0])
@@ -184,6 +226,18 @@ func (l *Line) Intersection(line *Line) *Point {
 	return l.Interpolate(U1 / den)
 }
 
+// Clip returns a new line of the portion of the line within the bound, with the
+// exact points where the line crosses the boundary, or nil if the line is outside
+// the bound. Uses the Liang-Barsky algorithm.
+func (l *Line) Clip(bound *Bound) *Line {
+	a, b, ok := clipSegment(l.a, l.b, bound)
+	if !ok {
+		return nil
+	}
+
+	return &Line{a, b}
+}
+
 // Intersects will return true if the lines are collinear AND intersect.
 // Based on: http://www.geeksforgeeks.org/check-if-two-given-line-segments-intersect/
 func (l *Line) Intersects(line *Line) bool {
@@ -159,6 +159,29 @@ func TestLineMeasure(t *testing.T) {
 	}
 }
 
+func TestLineClosestPointTo(t *testing.T) {
+	l := NewLine(NewPoint(0, 0), NewPoint(0, 4))
+
+	point := l.ClosestPointTo(NewPoint(1, 2))
+	if expected := NewPoint(0, 2); !point.Equals(expected) {
+		t.Errorf("line, closest point expected %v, got %v", expected, point)
+	}
+
+	point = l.ClosestPointTo(NewPoint(1, -2))
+	if expected := NewPoint(0, 0); !point.Equals(expected) {
+		t.Errorf("line, closest point expected %v, got %v", expected, point)
+	}
+
+	point = l.ClosestPointTo(NewPoint(1, 6))
+	if expected := NewPoint(0, 4); !point.Equals(expected) {
+		t.Errorf("line, closest point expected %v, got %v", expected, point)
+	}
+
+	if point == l.B() {
+		t.Error("line, closest point should return a copy")
+	}
+}
+
 func TestDirection(t *testing.T) {
 	lines := []*Line{
 		NewLine(NewPoint(0, 0), NewPoint(1, 0)),
@@ -223,6 +246,42 @@ func TestLineInterpolate(t *testing.T) {
 	}
 }
 
+func TestLineGeoInterpolate(t *testing.T) {
+	l := NewLine(NewPoint(-1.8444, 53.1506), NewPoint(0.1406, 52.2047))
+
+	if p := l.GeoInterpolate(0); math.Abs(p[0]-l.a[0]) > epsilon || math.Abs(p[1]-l.a[1]) > epsilon {
+		t.Errorf("line, geointerpolate expected %v, got %v", l.a, p)
+	}
+
+	if p := l.GeoInterpolate(1); math.Abs(p[0]-l.b[0]) > epsilon || math.Abs(p[1]-l.b[1]) > epsilon {
+		t.Errorf("line, geointerpolate expected %v, got %v", l.b, p)
+	}
+
+	answer := l.GeoMidpoint()
+	if p := l.GeoInterpolate(0.5); math.Abs(p[0]-answer[0]) > epsilon || math.Abs(p[1]-answer[1]) > epsilon {
+		t.Errorf("line, geointerpolate expected %v, got %v", answer, p)
+	}
+
+	// should follow the great circle, north of the straight line
+	l = NewLine(NewPoint(-74.0060, 40.7128), NewPoint(-0.1278, 51.5074))
+	p := l.GeoInterpolate(0.5)
+	if p.Lat() < l.Midpoint().Lat() {
+		t.Errorf("line, geointerpolate should be on great circle, got %v", p)
+	}
+
+	d1 := l.A().GeoDistanceFrom(p, true)
+	d2 := p.GeoDistanceFrom(l.B(), true)
+	if math.Abs(d1-d2) > 1e-3 {
+		t.Errorf("line, geointerpolate should be half way, %f != %f", d1, d2)
+	}
+
+	// same point
+	l = NewLine(NewPoint(1, 2), NewPoint(1, 2))
+	if p := l.GeoInterpolate(0.5); !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("line, geointerpolate of same points should be the point, got %v", p)
+	}
+}
+
 func TestLineSide(t *testing.T) {
 	l := NewLine(NewPoint(0, 0), NewPoint(0, 10))
 
@@ -287,6 +346,38 @@ func TestLineIntersection(t *testing.T) {
 	}
 }
 
+func TestLineClip(t *testing.T) {
+	bound := NewBound(0, 4, 0, 2)
+
+	tests := []struct {
+		Line   *Line
+		Answer *Line
+	}{
+		{NewLine(NewPoint(1, 1), NewPoint(3, 1)), NewLine(NewPoint(1, 1), NewPoint(3, 1))},
+		{NewLine(NewPoint(-1, 1), NewPoint(5, 1)), NewLine(NewPoint(0, 1), NewPoint(4, 1))},
+		{NewLine(NewPoint(5, 1), NewPoint(-1, 1)), NewLine(NewPoint(4, 1), NewPoint(0, 1))},
+		{NewLine(NewPoint(2, 1), NewPoint(2, 5)), NewLine(NewPoint(2, 1), NewPoint(2, 2))},
+		{NewLine(NewPoint(-1, -1), NewPoint(3, 3)), NewLine(NewPoint(0, 0), NewPoint(2, 2))},
+		{NewLine(NewPoint(0, 2), NewPoint(4, 2)), NewLine(NewPoint(0, 2), NewPoint(4, 2))}, // on edge
+		{NewLine(NewPoint(5, 1), NewPoint(6, 1)), nil},
+		{NewLine(NewPoint(-1, 1), NewPoint(1, 5)), nil},
+	}
+
+	for i, test := range tests {
+		l := test.Line.Clip(bound)
+		if test.Answer == nil {
+			if l != nil {
+				t.Errorf("line, clip test %d expected nil, got %v", i, l)
+			}
+			continue
+		}
+
+		if l == nil || !l.Equals(test.Answer) {
+			t.Errorf("line, clip test %d expected %v, got %v", i, test.Answer, l)
+		}
+	}
+}
+
 func TestLineIntersects(t *testing.T) {
 	var answer bool
 	l := NewLine(NewPoint(0, 0), NewPoint(1, 1))
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
+	"strconv"
 
 	"github.com/paulmach/go.geojson"
 )
@@ -26,15 +28,50 @@ func NewPathPreallocate(length, capacity int) *Path {
 
 // NewPathFromEncoding is the inverse of path.Encode. It takes a string encoding of a lat/lng path
 // and returns the actual path it represents. Factor defaults to 1.0e5,
-// the same used by Google for polyline encoding.
+// the same used by Google for polyline encoding. Invalid or truncated input is
+// ignored from that point on, use DecodePolyline to get an error instead.
 func NewPathFromEncoding(encoded string, factor ...int) *Path {
-	var count, index int
+	f := 1.0e5
+	if len(factor) != 0 {
+		f = float64(factor[0])
+	}
+
+	p, _ := decodePolyline(encoded, f)
+	return p
+}
 
+// DecodePolyline is similar to NewPathFromEncoding but returns an error
+// for invalid characters, truncated input and coordinates outside
+// of the valid lng/lat range. Factor defaults to 1.0e5, the same used by Google.
+// Use 1.0e6 for the 6 digit precision used by OSRM and Valhalla. Factors less
+// than 10 are an error as they are likely a precision, eg. 6, not a factor.
+func DecodePolyline(encoded string, factor ...int) (*Path, error) {
 	f := 1.0e5
 	if len(factor) != 0 {
+		if factor[0] < 10 {
+			return nil, fmt.Errorf("geo: polyline factor must be at least 10, got %d, eg. use 1e6 for 6 digits", factor[0])
+		}
+
 		f = float64(factor[0])
 	}
 
+	p, err := decodePolyline(encoded, f)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, point := range p.PointSet {
+		if point.Lat() < -90 || point.Lat() > 90 || point.Lng() < -180 || point.Lng() > 180 {
+			return nil, fmt.Errorf("geo: polyline point %d out of lng/lat range, got %v", i, point)
+		}
+	}
+
+	return p, nil
+}
+
+func decodePolyline(encoded string, f float64) (*Path, error) {
+	var count, index int
+
 	p := &Path{PointSet{}}
 	tempLatLng := [2]int{0, 0}
 
@@ -44,7 +81,14 @@ func NewPathFromEncoding(encoded string, factor ...int) *Path {
 		var shift uint
 
 		for b >= 0x20 {
+			if index >= len(encoded) {
+				return p, fmt.Errorf("geo: polyline truncated at index %d", index)
+			}
+
 			b = int(encoded[index]) - 63
+			if b < 0 || b > 63 {
+				return p, fmt.Errorf("geo: invalid polyline character %q at index %d", encoded[index], index)
+			}
 			index++
 
 			result |= (b & 0x1f) << shift
@@ -71,7 +115,11 @@ func NewPathFromEncoding(encoded string, factor ...int) *Path {
 		count++
 	}
 
-	return p
+	if count%2 != 0 {
+		return p, fmt.Errorf("geo: polyline truncated, latitude without longitude at index %d", index)
+	}
+
+	return p, nil
 }
 
 // NewPathFromXYData creates a path from a slice of [2]float64 values
@@ -128,6 +176,67 @@ func NewPathFromYXSlice(data [][]float64) *Path {
 	return p
 }
 
+// NewPathFromFlatCoords creates a path from a flat slice of [x0, y0, x1, y1, ...] values,
+// the format used by many rendering and geometry libraries.
+// Returns an error if the slice has an odd length.
+func NewPathFromFlatCoords(coords []float64) (*Path, error) {
+	if len(coords)%2 != 0 {
+		return nil, fmt.Errorf("geo: flat coords must have an even length, got %d", len(coords))
+	}
+
+	p := NewPathPreallocate(0, len(coords)/2)
+	for i := 0; i < len(coords); i += 2 {
+		p.PointSet = append(p.PointSet, Point{coords[i], coords[i+1]})
+	}
+
+	return p, nil
+}
+
+// NewPathFromGeoJSON creates a path from a geojson geometry. LineString and MultiPoint
+// geometries are supported, a Point becomes a single point path.
+// Any extra values of the positions, like elevation, are ignored.
+// Returns ErrIncorrectGeometry for other geometry types.
+func NewPathFromGeoJSON(g *geojson.Geometry) (*Path, error) {
+	if g == nil {
+		return nil, ErrIncorrectGeometry
+	}
+
+	var p *Path
+	switch {
+	case g.IsLineString():
+		p = NewPathFromXYSlice(g.LineString)
+	case g.IsMultiPoint():
+		p = NewPathFromXYSlice(g.MultiPoint)
+	case g.IsPoint():
+		p = NewPathFromXYSlice([][]float64{g.Point})
+	default:
+		return nil, ErrIncorrectGeometry
+	}
+
+	return p, nil
+}
+
+// NewPathFromWKT creates a path from a WKT linestring, eg. LINESTRING(30 10,10 30,40 40).
+// The linestring must have at least two points. Parsed by NewGeometryFromWKT
+// so extra values of the positions are ignored. Errors include the full input.
+func NewPathFromWKT(wkt string) (*Path, error) {
+	g, err := NewGeometryFromWKT(wkt)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := g.(*Path)
+	if !ok {
+		return nil, fmt.Errorf("geo: wkt is not a linestring: %q", wkt)
+	}
+
+	if len(p.PointSet) < 2 {
+		return nil, fmt.Errorf("geo: wkt linestring must have at least 2 points: %q", wkt)
+	}
+
+	return p, nil
+}
+
 // SetPoints allows you to set the complete pointset yourself.
 // Note that the input is an array of Points (not pointers to points).
 func (p *Path) SetPoints(points []Point) *Path {
@@ -151,6 +260,156 @@ func (p *Path) Transform(projector Projector) *Path {
 	return p
 }
 
+// Translate returns a new path with every point offset by the given vector.
+// The original path is not modified.
+func (p *Path) Translate(vector *Point) *Path {
+	result := p.Clone()
+	for i := range result.PointSet {
+		result.PointSet[i].Add(vector)
+	}
+
+	return result
+}
+
+// Scale returns a new path with every point scaled by the given factor relative
+// to the center. Scaling about the centroid keeps the shape centered.
+// A factor of 1 returns an identical copy. The original path is not modified.
+func (p *Path) Scale(factor float64, center *Point) *Path {
+	result := p.Clone()
+	if factor == 1 {
+		return result
+	}
+
+	for i := range result.PointSet {
+		result.PointSet[i].Subtract(center).Scale(factor).Add(center)
+	}
+
+	return result
+}
+
+// Rotate returns a new path with every point rotated counter-clockwise by the given
+// number of degrees around the center. The rotation is in the plane, not on
+// the sphere, so is only appropriate for small areas of lng/lat data.
+// The original path is not modified.
+func (p *Path) Rotate(angleDegrees float64, center *Point) *Path {
+	sin, cos := math.Sincos(deg2rad(angleDegrees))
+
+	result := p.Clone()
+	for i, point := range p.PointSet {
+		x := point[0] - center[0]
+		y := point[1] - center[1]
+
+		result.PointSet[i][0] = center[0] + x*cos - y*sin
+		result.PointSet[i][1] = center[1] + x*sin + y*cos
+	}
+
+	return result
+}
+
+// Reverse returns a new path with the points in reverse order.
+// The original path is not modified.
+func (p *Path) Reverse() *Path {
+	points := make([]Point, len(p.PointSet))
+	for i, point := range p.PointSet {
+		points[len(points)-1-i] = point
+	}
+
+	return &Path{points}
+}
+
+// RemoveDuplicates removes consecutive duplicate points from the path, in place.
+// Use p.Clone().RemoveDuplicates() to keep the original.
+func (p *Path) RemoveDuplicates() *Path {
+	if len(p.PointSet) < 2 {
+		return p
+	}
+
+	points := p.PointSet[:1]
+	for _, point := range p.PointSet[1:] {
+		if point != points[len(points)-1] {
+			points = append(points, point)
+		}
+	}
+
+	p.PointSet = points
+	return p
+}
+
+// RemoveDuplicatesFuzzy removes points within epsilon distance of the previous
+// kept point, in place. Assumes euclidean geometry.
+func (p *Path) RemoveDuplicatesFuzzy(epsilon float64) *Path {
+	if len(p.PointSet) < 2 {
+		return p
+	}
+
+	epsilon *= epsilon
+	points := p.PointSet[:1]
+	for _, point := range p.PointSet[1:] {
+		if point.SquaredDistanceFrom(&points[len(points)-1]) > epsilon {
+			points = append(points, point)
+		}
+	}
+
+	p.PointSet = points
+	return p
+}
+
+// IsRing returns true if the path has at least two points and
+// the first point equals the last, ie. the path is closed.
+func (p *Path) IsRing() bool {
+	return len(p.PointSet) > 1 && p.PointSet[0] == p.PointSet[len(p.PointSet)-1]
+}
+
+// Close appends a copy of the first point to the end of the path,
+// if the path is not already a ring.
+func (p *Path) Close() *Path {
+	if len(p.PointSet) == 0 || p.IsRing() {
+		return p
+	}
+
+	p.PointSet = append(p.PointSet, p.PointSet[0])
+	return p
+}
+
+// ConcatOptions are the options used when concatenating paths.
+type ConcatOptions struct {
+	// KeepDuplicateJoins keeps the first point of a following path
+	// even if it equals the current last point.
+	KeepDuplicateJoins bool
+}
+
+// Concat returns a new path of this path followed by the points of the others.
+// The first point of a following path is skipped if it equals the current last point,
+// so coincident join points are not duplicated. The paths are not modified.
+func (p *Path) Concat(others ...*Path) *Path {
+	return p.ConcatWithOptions(ConcatOptions{}, others...)
+}
+
+// ConcatWithOptions returns a new path of this path followed by the points of the others.
+// Coincident join points are only duplicated if opts.KeepDuplicateJoins is true.
+// The paths are not modified.
+func (p *Path) ConcatWithOptions(opts ConcatOptions, others ...*Path) *Path {
+	result := p.Clone()
+	for _, other := range others {
+		points := other.PointSet
+		if !opts.KeepDuplicateJoins && len(result.PointSet) != 0 && len(points) != 0 &&
+			result.PointSet[len(result.PointSet)-1].Equals(&points[0]) {
+			points = points[1:]
+		}
+
+		result.PointSet = append(result.PointSet, points...)
+	}
+
+	return result
+}
+
+// ConcatenatePaths returns a new path of the given paths joined end to end.
+// Join points are only deduplicated if they are equal, see Concat.
+// The given paths are not modified.
+func ConcatenatePaths(paths ...*Path) *Path {
+	return NewPath().Concat(paths...)
+}
+
 // Decode is deprecated, use NewPathFromEncoding
 func Decode(encoded string, factor ...int) *Path {
 	return NewPathFromEncoding(encoded, factor...)
@@ -230,6 +489,122 @@ func (p *Path) GeoDistance(haversine ...bool) float64 {
 	return sum
 }
 
+// CumulativeDistances returns the distance along the path to each point, so the
+// first value is 0 and the last is the Distance of the path. Compute once and reuse
+// for many distance based lookups. Assumes euclidean geometry.
+func (p *Path) CumulativeDistances() []float64 {
+	dists := make([]float64, len(p.PointSet))
+	for i := 1; i < len(p.PointSet); i++ {
+		dists[i] = dists[i-1] + p.PointSet[i-1].DistanceFrom(&p.PointSet[i])
+	}
+
+	return dists
+}
+
+// CumulativeGeoDistances returns the distance, in meters, along the path to each point,
+// so the first value is 0 and the last is the GeoDistance of the path.
+// Compute once and reuse for many distance based lookups.
+func (p *Path) CumulativeGeoDistances(haversine ...bool) []float64 {
+	yesgeo := yesHaversine(haversine)
+
+	dists := make([]float64, len(p.PointSet))
+	for i := 1; i < len(p.PointSet); i++ {
+		dists[i] = dists[i-1] + p.PointSet[i-1].GeoDistanceFrom(&p.PointSet[i], yesgeo)
+	}
+
+	return dists
+}
+
+// Perimeter computes the distance around the path treated as a closed polygon,
+// ie. the Distance plus the closing segment from the last point back to the first.
+// The closing segment is zero for paths that are already closed.
+// Returns 0 for single point and empty paths.
+func (p *Path) Perimeter() float64 {
+	if len(p.PointSet) < 2 {
+		return 0
+	}
+
+	return p.Distance() + p.PointSet[len(p.PointSet)-1].DistanceFrom(&p.PointSet[0])
+}
+
+// GeoPerimeter computes the geo distance around the path treated as a closed polygon,
+// ie. the GeoDistance plus the closing segment from the last point back to the first.
+// Returns 0 for single point and empty paths.
+func (p *Path) GeoPerimeter(haversine ...bool) float64 {
+	if len(p.PointSet) < 2 {
+		return 0
+	}
+
+	yesgeo := yesHaversine(haversine)
+	return p.GeoDistance(yesgeo) + p.PointSet[len(p.PointSet)-1].GeoDistanceFrom(&p.PointSet[0], yesgeo)
+}
+
+// Densify inserts points along each segment, by linear interpolation,
+// so that no segment is longer than the given distance.
+// The original points are kept, unchanged and in order.
+// Assumes euclidean geometry.
+func (p *Path) Densify(dist float64) *Path {
+	if dist <= 0 {
+		panic("geo: densify distance must be > 0")
+	}
+
+	if len(p.PointSet) < 2 {
+		return p
+	}
+
+	points := make([]Point, 0, len(p.PointSet))
+	points = append(points, p.PointSet[0])
+
+	seg := &Line{}
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		seg.a = p.PointSet[i]
+		seg.b = p.PointSet[i+1]
+
+		count := int(math.Ceil(seg.Distance() / dist))
+		for j := 1; j < count; j++ {
+			points = append(points, *seg.Interpolate(float64(j) / float64(count)))
+		}
+
+		points = append(points, seg.b)
+	}
+
+	(&p.PointSet).SetPoints(points)
+	return p
+}
+
+// GeoDensify inserts points along the great circle path of each segment
+// so that no segment is longer than the given number of meters.
+// The original points are kept, unchanged and in order.
+// Distances are computed using the Haversine formula.
+func (p *Path) GeoDensify(meters float64) *Path {
+	if meters <= 0 {
+		panic("geo: densify distance must be > 0")
+	}
+
+	if len(p.PointSet) < 2 {
+		return p
+	}
+
+	points := make([]Point, 0, len(p.PointSet))
+	points = append(points, p.PointSet[0])
+
+	seg := &Line{}
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		seg.a = p.PointSet[i]
+		seg.b = p.PointSet[i+1]
+
+		count := int(math.Ceil(seg.GeoDistance(true) / meters))
+		for j := 1; j < count; j++ {
+			points = append(points, *seg.GeoInterpolate(float64(j) / float64(count)))
+		}
+
+		points = append(points, seg.b)
+	}
+
+	(&p.PointSet).SetPoints(points)
+	return p
+}
+
 // DistanceFrom computes an O(n) distance from the path. Loops over every
 // subline to find the minimum distance.
 func (p *Path) DistanceFrom(point *Point) float64 {
@@ -252,6 +627,95 @@ func (p *Path) SquaredDistanceFrom(point *Point) float64 {
 	return dist
 }
 
+// NearestVertex returns the index of, and distance to, the point in the path
+// closest to the given point. This is a vertex, not the closest point on a segment,
+// see ClosestPointTo for that. Returns -1, Inf for empty paths. Assumes euclidean geometry.
+func (p *Path) NearestVertex(point *Point) (int, float64) {
+	if len(p.PointSet) == 0 {
+		return -1, math.Inf(1)
+	}
+
+	dist, index := p.PointSet.DistanceFrom(point)
+	return index, dist
+}
+
+// GeoNearestVertex returns the index of, and geo distance to, the point in the path
+// closest to the given point. The path must be in lng/lat (EPSG:4326).
+// Vertices are compared using squared equirectangular distances, only the distance
+// to the nearest one is computed using GeoDistanceFrom. Longitude differences wrap
+// at the antimeridian. Returns -1, Inf for empty paths.
+func (p *Path) GeoNearestVertex(point *Point) (int, float64) {
+	if len(p.PointSet) == 0 {
+		return -1, math.Inf(1)
+	}
+
+	cos := math.Cos(deg2rad(point.Lat()))
+	cos *= cos
+
+	index := 0
+	dist := math.Inf(1)
+	for i := range p.PointSet {
+		dLat := p.PointSet[i][1] - point[1]
+		dLng := deltaLng(p.PointSet[i][0], point[0])
+
+		if d := dLat*dLat + dLng*dLng*cos; d < dist {
+			dist = d
+			index = i
+		}
+	}
+
+	// measure to the nearest vertex on the same side of the antimeridian
+	nearest := p.PointSet[index]
+	if d := nearest[0] - point[0]; d > 180 {
+		nearest[0] -= 360
+	} else if d < -180 {
+		nearest[0] += 360
+	}
+
+	return index, point.GeoDistanceFrom(&nearest)
+}
+
+// VerticesWithin returns the indexes of the points in the path within
+// the radius of the given point. Assumes euclidean geometry.
+func (p *Path) VerticesWithin(point *Point, radius float64) []int {
+	var indexes []int
+
+	radius *= radius
+	for i := range p.PointSet {
+		if p.PointSet[i].SquaredDistanceFrom(point) <= radius {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// GeoVerticesWithin returns the indexes of the points in the path within the given
+// number of meters of the point. The path must be in lng/lat (EPSG:4326).
+// Uses squared equirectangular distances so is approximate for large distances.
+// Longitude differences wrap at the antimeridian.
+func (p *Path) GeoVerticesWithin(point *Point, meters float64) []int {
+	var indexes []int
+
+	cos := math.Cos(deg2rad(point.Lat()))
+	cos *= cos
+
+	// in degrees, squared
+	radius := rad2deg(meters / EarthRadius)
+	radius *= radius
+
+	for i := range p.PointSet {
+		dLat := p.PointSet[i][1] - point[1]
+		dLng := deltaLng(p.PointSet[i][0], point[0])
+
+		if dLat*dLat+dLng*dLng*cos <= radius {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
 // DirectionAt computes the direction of the path at the given index.
 // Uses the line between the two surrounding points to get the direction,
 // or just the first two, or last two if at the start or end, respectively.
@@ -280,6 +744,92 @@ func (p *Path) DirectionAt(index int) float64 {
 	return math.Atan2(diff.Y(), diff.X())
 }
 
+// BearingAt computes the bearing, using BearingTo, of the segment
+// starting at the given index. The last segment is used for the last point.
+// The path must be in lng/lat (EPSG:4326). The units are degrees from north, range [-180, 180].
+// Returns 0 for single point paths.
+func (p *Path) BearingAt(index int) float64 {
+	if index >= len(p.PointSet) || index < 0 {
+		panic(fmt.Sprintf("geo: bearing at index out of range, requested: %d, length: %d", index, len(p.PointSet)))
+	}
+
+	if len(p.PointSet) == 1 {
+		return 0
+	}
+
+	if index == len(p.PointSet)-1 {
+		index--
+	}
+
+	return p.PointSet[index].BearingTo(&p.PointSet[index+1])
+}
+
+// BearingAtFraction computes the bearing, using BearingTo, of the segment containing
+// the given fraction of the path's geo distance. Exactly at a vertex the incoming
+// segment is used, or the first segment at the start. If smooth is true the bearing
+// at a vertex is the average of the incoming and outgoing segments.
+// Zero length segments are skipped. The path must be in lng/lat (EPSG:4326).
+// The units are degrees from north, range [-180, 180]. Returns 0 for paths with no length.
+func (p *Path) BearingAtFraction(fraction float64, smooth ...bool) float64 {
+	total := p.GeoDistance()
+	if total == 0 {
+		return 0
+	}
+
+	dist := math.Max(0, math.Min(1, fraction)) * total
+	yesSmooth := len(smooth) != 0 && smooth[0]
+
+	sum := 0.0
+	incoming := 0.0
+	atVertex := false
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		d := p.PointSet[i].GeoDistanceFrom(&p.PointSet[i+1])
+		if d == 0 {
+			continue
+		}
+
+		bearing := p.PointSet[i].BearingTo(&p.PointSet[i+1])
+		if atVertex {
+			return averageBearing(incoming, bearing)
+		}
+
+		sum += d
+		if sum >= dist {
+			if !yesSmooth || math.Abs(sum-dist) > 1e-9*total {
+				return bearing
+			}
+
+			incoming = bearing
+			atVertex = true
+		}
+	}
+
+	// at the last point, so there is no outgoing segment
+	return incoming
+}
+
+// Bearings returns the bearing, using BearingTo, of each segment of the path.
+// Zero length segments have a bearing of 0. The path must be in lng/lat (EPSG:4326).
+// The units are degrees from north, range [-180, 180].
+func (p *Path) Bearings() []float64 {
+	if len(p.PointSet) < 2 {
+		return []float64{}
+	}
+
+	bearings := make([]float64, 0, len(p.PointSet)-1)
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		bearings = append(bearings, p.PointSet[i].BearingTo(&p.PointSet[i+1]))
+	}
+
+	return bearings
+}
+
+// averageBearing computes the circular mean of two bearings in degrees.
+func averageBearing(a, b float64) float64 {
+	aRad, bRad := deg2rad(a), deg2rad(b)
+	return rad2deg(math.Atan2(math.Sin(aRad)+math.Sin(bRad), math.Cos(aRad)+math.Cos(bRad)))
+}
+
 // Measure computes the distance along this path to the point nearest the given point.
 func (p *Path) Measure(point *Point) float64 {
 	minDistance := math.Inf(1)
@@ -300,6 +850,162 @@ func (p *Path) Measure(point *Point) float64 {
 	return measure
 }
 
+// ClosestPointTo returns the point on the path nearest the given point,
+// along with the index of the segment it lies on and the fractional position,
+// in [0, 1], within that segment. This is O(n) as every segment is checked.
+// Returns nil, -1, 0 for empty paths and the only point for single point paths.
+func (p *Path) ClosestPointTo(point *Point) (*Point, int, float64) {
+	if len(p.PointSet) == 0 {
+		return nil, -1, 0
+	}
+
+	if len(p.PointSet) == 1 {
+		return p.PointSet[0].Clone(), 0, 0
+	}
+
+	minDistance := math.Inf(1)
+	index := 0
+
+	seg := &Line{}
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		seg.a = p.PointSet[i]
+		seg.b = p.PointSet[i+1]
+		if d := seg.SquaredDistanceFrom(point); d < minDistance {
+			minDistance = d
+			index = i
+		}
+	}
+
+	seg.a = p.PointSet[index]
+	seg.b = p.PointSet[index+1]
+
+	fraction := math.Max(0, math.Min(1, seg.Project(point)))
+	return seg.ClosestPointTo(point), index, fraction
+}
+
+// PointAtDistance returns the point the given distance along the path,
+// linearly interpolated within the segment. Returns a copy of the first or last point
+// if the distance is before the start or past the end of the path.
+// Returns nil for empty paths. Assumes euclidean geometry.
+func (p *Path) PointAtDistance(dist float64) *Point {
+	return p.pointAtDistance(dist, func(a, b *Point) float64 {
+		return a.DistanceFrom(b)
+	})
+}
+
+// GeoPointAtDistance returns the point the given number of meters along the path,
+// using spherical (lng/lat) geometry to measure the segments. The point is
+// linearly interpolated within the segment, the same as GeoResample.
+// Returns nil for empty paths.
+func (p *Path) GeoPointAtDistance(meters float64) *Point {
+	return p.pointAtDistance(meters, func(a, b *Point) float64 {
+		return a.GeoDistanceFrom(b)
+	})
+}
+
+func (p *Path) pointAtDistance(dist float64, distance func(a, b *Point) float64) *Point {
+	if len(p.PointSet) == 0 {
+		return nil
+	}
+
+	if dist <= 0 {
+		return p.PointSet[0].Clone()
+	}
+
+	sum := 0.0
+	seg := &Line{}
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		seg.a = p.PointSet[i]
+		seg.b = p.PointSet[i+1]
+
+		d := distance(&seg.a, &seg.b)
+		if sum+d >= dist && d != 0 {
+			return seg.Interpolate((dist - sum) / d)
+		}
+
+		sum += d
+	}
+
+	return p.PointSet[len(p.PointSet)-1].Clone()
+}
+
+// SubPath returns a new path of the points from start, inclusive, to end, exclusive,
+// similar to Go slice syntax. Indexes are clamped to the path and an empty path is
+// returned if start is not before end. The original path is not modified.
+func (p *Path) SubPath(start, end int) *Path {
+	if start < 0 {
+		start = 0
+	}
+
+	if end > len(p.PointSet) {
+		end = len(p.PointSet)
+	}
+
+	if start >= end {
+		return NewPath()
+	}
+
+	points := make([]Point, end-start)
+	copy(points, p.PointSet[start:end])
+
+	return NewPath().SetPoints(points)
+}
+
+// Slice returns a new path of the portion between the two fractions of the path's
+// distance, interpolating new endpoints within segments as needed. Fractions are
+// clamped to [0, 1]. If start is greater than end the portion is returned reversed.
+// The original path is not modified. Assumes euclidean geometry.
+func (p *Path) Slice(startFraction, endFraction float64) *Path {
+	if startFraction > endFraction {
+		return p.Slice(endFraction, startFraction).Reverse()
+	}
+
+	if len(p.PointSet) == 0 {
+		return NewPath()
+	}
+
+	total := p.Distance()
+	start := math.Max(0, math.Min(1, startFraction)) * total
+	end := math.Max(0, math.Min(1, endFraction)) * total
+
+	result := NewPath()
+	result.Push(p.PointAtDistance(start))
+
+	sum := 0.0
+	for i := 1; i < len(p.PointSet); i++ {
+		sum += p.PointSet[i-1].DistanceFrom(&p.PointSet[i])
+		if sum >= end {
+			break
+		}
+
+		if sum > start {
+			result.Push(&p.PointSet[i])
+		}
+	}
+
+	result.Push(p.PointAtDistance(end))
+	return result
+}
+
+// SplitAt splits the path into two new paths at the given fraction of the distance.
+// Both halves share the interpolated split point as their common endpoint.
+// Splitting at 0, or 1, returns a degenerate first, or second, half made up of
+// two copies of the endpoint. The original path is not modified.
+func (p *Path) SplitAt(fraction float64) (*Path, *Path) {
+	return p.Slice(0, fraction), p.Slice(fraction, 1)
+}
+
+// SplitAtPoint splits the path at the projection of the given point onto the path.
+// See SplitAt for details on the returned halves.
+func (p *Path) SplitAtPoint(point *Point) (*Path, *Path) {
+	total := p.Distance()
+	if total == 0 {
+		return p.SplitAt(0)
+	}
+
+	return p.SplitAt(p.Measure(point) / total)
+}
+
 // Project computes the measure along this path closest to the given point,
 // normalized to the length of the path.
 func (p *Path) Project(point *Point) float64 {
@@ -331,24 +1037,44 @@ func (p *Path) Intersection(geometry interface{}) ([]*Point, [][2]int) {
 
 // IntersectionPath returns a slice of points and a slice of tuples [i, j] where i is the segment
 // in the parent path and j is the segment in the given path that intersect to form the given point.
+// Points are in order along the parent path. Collinear overlapping segments result
+// in an InfinityPoint, after the other points on that segment.
 // Slices will be empty if there is no intersection.
 func (p *Path) IntersectionPath(path *Path) ([]*Point, [][2]int) {
 	// TODO: done some sort of line sweep here if p.Length() is big enough
 	var points []*Point
 	var indexes [][2]int
 
-	for i := 0; i < len(p.PointSet)-1; i++ {
-		pLine := NewLine(&p.PointSet[i], &p.PointSet[i+1])
+	if len(p.PointSet) == 0 || len(path.PointSet) == 0 || !p.Bound().Intersects(path.Bound()) {
+		return points, indexes
+	}
 
-		for j := 0; j < len(path.PointSet)-1; j++ {
-			pathLine := NewLine(&path.PointSet[j], &path.PointSet[j+1])
+	p.Segments(func(i int, pLine Line) bool {
+		start := len(points)
+		path.Segments(func(j int, pathLine Line) bool {
+			if !segmentBoundsIntersect(&pLine, &pathLine) {
+				return true
+			}
 
-			if point := pLine.Intersection(pathLine); point != nil {
+			if point := pLine.Intersection(&pathLine); point != nil {
 				points = append(points, point)
 				indexes = append(indexes, [2]int{i, j})
 			}
+
+			return true
+		})
+
+		// order the points on this segment by distance from its start,
+		// insertion sort as there are usually very few.
+		for k := start + 1; k < len(points); k++ {
+			for l := k; l > start && points[l].SquaredDistanceFrom(&pLine.a) < points[l-1].SquaredDistanceFrom(&pLine.a); l-- {
+				points[l], points[l-1] = points[l-1], points[l]
+				indexes[l], indexes[l-1] = indexes[l-1], indexes[l]
+			}
 		}
-	}
+
+		return true
+	})
 
 	return points, indexes
 }
@@ -360,13 +1086,14 @@ func (p *Path) IntersectionLine(line *Line) ([]*Point, [][2]int) {
 	var points []*Point
 	var indexes [][2]int
 
-	for i := 0; i < len(p.PointSet)-1; i++ {
-		pTest := NewLine(&p.PointSet[i], &p.PointSet[i+1])
-		if point := pTest.Intersection(line); point != nil {
+	p.Segments(func(i int, segment Line) bool {
+		if point := segment.Intersection(line); point != nil {
 			points = append(points, point)
 			indexes = append(indexes, [2]int{i, 0})
 		}
-	}
+
+		return true
+	})
 
 	return points, indexes
 }
@@ -385,44 +1112,415 @@ func (p *Path) Intersects(geometry interface{}) bool {
 		result = p.IntersectsPath(&g)
 	case *Path:
 		result = p.IntersectsPath(g)
+	case Bound:
+		result = p.IntersectsBound(&g)
+	case *Bound:
+		result = p.IntersectsBound(g)
 	default:
-		panic("can only determine intersection with lines and paths")
+		panic("can only determine intersection with lines, paths and bounds")
 	}
 
 	return result
 }
 
 // IntersectsPath takes a Path and checks if it intersects with the path.
+// Returns as soon as the first intersecting segments are found.
 func (p *Path) IntersectsPath(path *Path) bool {
+	if len(p.PointSet) == 0 || len(path.PointSet) == 0 || !p.Bound().Intersects(path.Bound()) {
+		return false
+	}
+
 	// TODO: done some sort of line sweep here if p.Length() is big enough
-	for i := 0; i < len(p.PointSet)-1; i++ {
-		pLine := NewLine(&p.PointSet[i], &p.PointSet[i+1])
+	intersects := false
+	p.Segments(func(i int, pLine Line) bool {
+		path.Segments(func(j int, pathLine Line) bool {
+			intersects = segmentBoundsIntersect(&pLine, &pathLine) && pLine.Intersects(&pathLine)
+			return !intersects
+		})
 
-		for j := 0; j < len(path.PointSet)-1; j++ {
-			pathLine := NewLine(&path.PointSet[j], &path.PointSet[j+1])
+		return !intersects
+	})
 
-			if pLine.Intersects(pathLine) {
-				return true
-			}
+	return intersects
+}
+
+// segmentBoundsIntersect is a quick check, without allocations,
+// to rule out segments that can not intersect.
+func segmentBoundsIntersect(l1, l2 *Line) bool {
+	return math.Max(l1.a[0], l1.b[0]) >= math.Min(l2.a[0], l2.b[0]) &&
+		math.Min(l1.a[0], l1.b[0]) <= math.Max(l2.a[0], l2.b[0]) &&
+		math.Max(l1.a[1], l1.b[1]) >= math.Min(l2.a[1], l2.b[1]) &&
+		math.Min(l1.a[1], l1.b[1]) <= math.Max(l2.a[1], l2.b[1])
+}
+
+// IntersectsBound checks if the path intersects the bound, ie. if any point is
+// within the bound or any segment crosses the bound's edges.
+// The bound of the path is checked first to quickly rule out far away paths.
+func (p *Path) IntersectsBound(bound *Bound) bool {
+	if len(p.PointSet) == 0 || !p.Bound().Intersects(bound) {
+		return false
+	}
+
+	for i := range p.PointSet {
+		if bound.Contains(&p.PointSet[i]) {
+			return true
 		}
 	}
 
-	return false
+	// no points are inside so a segment must cross an edge to intersect
+	sw, ne := *bound.sw, *bound.ne
+	nw, se := Point{sw[0], ne[1]}, Point{ne[0], sw[1]}
+	edges := [4]Line{{sw, nw}, {nw, ne}, {ne, se}, {se, sw}}
+
+	intersects := false
+	p.Segments(func(i int, segment Line) bool {
+		for j := range edges {
+			if segment.Intersects(&edges[j]) {
+				intersects = true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return intersects
 }
 
 // IntersectsLine takes a Line and checks if it intersects with the path.
 func (p *Path) IntersectsLine(line *Line) bool {
+	intersects := false
+	p.Segments(func(i int, segment Line) bool {
+		intersects = segment.Intersects(line)
+		return !intersects
+	})
+
+	return intersects
+}
+
+// SelfIntersects checks if any two non-adjacent segments of the path intersect,
+// returning on the first intersection found. If the path is closed, the first
+// and last segments are considered adjacent.
+func (p *Path) SelfIntersects() bool {
+	points, _ := p.selfIntersections(true)
+	return len(points) != 0
+}
+
+// SelfIntersections returns a slice of points and a slice of tuples [i, j], i < j,
+// of the non-adjacent segments of the path that intersect to form the given point.
+// Segments touching at an earlier vertex are included. Slices will be empty if the
+// path does not intersect itself. The results are in no particular order.
+func (p *Path) SelfIntersections() ([]*Point, [][2]int) {
+	return p.selfIntersections(false)
+}
+
+// selfIntersections sorts the segments by their minimum x so only segments
+// with overlapping x ranges are compared.
+func (p *Path) selfIntersections(first bool) ([]*Point, [][2]int) {
+	var points []*Point
+	var indexes [][2]int
+
+	if len(p.PointSet) < 4 {
+		return points, indexes
+	}
+
+	last := len(p.PointSet) - 2
+	closed := p.IsRing()
+
+	sorter := &segmentSorter{points: p.PointSet, segments: make([]int, last+1)}
+	for i := range sorter.segments {
+		sorter.segments[i] = i
+	}
+	sort.Sort(sorter)
+
+	for a, i := range sorter.segments {
+		iLine := NewLine(&p.PointSet[i], &p.PointSet[i+1])
+		iMaxX := math.Max(iLine.a[0], iLine.b[0])
+		iMinY := math.Min(iLine.a[1], iLine.b[1])
+		iMaxY := math.Max(iLine.a[1], iLine.b[1])
+
+		for _, j := range sorter.segments[a+1:] {
+			if sorter.minX(j) > iMaxX {
+				break
+			}
+
+			lo, hi := i, j
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+
+			if hi-lo < 2 || (closed && lo == 0 && hi == last) {
+				continue
+			}
+
+			if math.Max(p.PointSet[j][1], p.PointSet[j+1][1]) < iMinY ||
+				math.Min(p.PointSet[j][1], p.PointSet[j+1][1]) > iMaxY {
+				continue
+			}
+
+			jLine := NewLine(&p.PointSet[j], &p.PointSet[j+1])
+			if !iLine.Intersects(jLine) {
+				continue
+			}
+
+			point := iLine.Intersection(jLine)
+			if point == nil || point == InfinityPoint {
+				point = touchPoint(iLine, jLine)
+			}
+
+			points = append(points, point)
+			indexes = append(indexes, [2]int{lo, hi})
+
+			if first {
+				return points, indexes
+			}
+		}
+	}
+
+	return points, indexes
+}
+
+// touchPoint returns an endpoint of one line that is on the other. Used when
+// Intersection can not find a single point, ie. collinear lines.
+func touchPoint(l1, l2 *Line) *Point {
+	for _, c := range [][2]*Line{{l1, l2}, {l2, l1}} {
+		if onSegment(&c[0].a, &c[0].b, &c[1].a) {
+			return c[1].a.Clone()
+		}
+
+		if onSegment(&c[0].a, &c[0].b, &c[1].b) {
+			return c[1].b.Clone()
+		}
+	}
+
+	// should only happen due to floating point error
+	if l1.SquaredDistanceFrom(&l2.a) < l1.SquaredDistanceFrom(&l2.b) {
+		return l2.a.Clone()
+	}
+
+	return l2.b.Clone()
+}
+
+// segmentSorter sorts segment indexes of a point set by their minimum x value.
+type segmentSorter struct {
+	points   PointSet
+	segments []int
+}
+
+func (s *segmentSorter) minX(i int) float64 {
+	return math.Min(s.points[i][0], s.points[i+1][0])
+}
+
+func (s *segmentSorter) Len() int {
+	return len(s.segments)
+}
+
+func (s *segmentSorter) Less(i, j int) bool {
+	return s.minX(s.segments[i]) < s.minX(s.segments[j])
+}
+
+func (s *segmentSorter) Swap(i, j int) {
+	s.segments[i], s.segments[j] = s.segments[j], s.segments[i]
+}
+
+// Winding returns +1 if the path, treated as a closed polygon, is wound
+// counter-clockwise and -1 if clockwise. Returns 0 for degenerate, zero area, paths.
+// Uses the sign of the shoelace formula, so assumes a planar projection.
+func (p *Path) Winding() int {
+	area := p.Area()
+	if area > 0 {
+		return 1
+	} else if area < 0 {
+		return -1
+	}
+
+	return 0
+}
+
+// Contains returns true if the point is strictly inside the path, treated as
+// a closed polygon even if the last point doesn't repeat the first.
+// Points on a vertex or edge are not contained, see ContainsWithBoundary.
+// Uses ray casting with the even-odd rule, so self-intersecting paths are supported.
+// Assumes a planar projection, paths crossing the antimeridian should use
+// continuous longitudes, eg. 170 to 190, and the point shifted to match.
+func (p *Path) Contains(point *Point) bool {
+	inside, boundary := p.pointInPolygon(point)
+	return inside && !boundary
+}
+
+// ContainsWithBoundary returns true if the point is inside the path, treated
+// as a closed polygon, or on one of its vertices or edges.
+// See Contains for more details.
+func (p *Path) ContainsWithBoundary(point *Point) bool {
+	inside, boundary := p.pointInPolygon(point)
+	return inside || boundary
+}
+
+// pointInPolygon returns if the point is inside using the even-odd rule, or on the boundary.
+// Edges are treated as half open in y, so horizontal edges and vertices
+// exactly on the ray are only counted once.
+func (p *Path) pointInPolygon(point *Point) (bool, bool) {
+	if len(p.PointSet) < 3 {
+		return false, false
+	}
+
+	inside := false
+	prev := p.PointSet[len(p.PointSet)-1]
+	for _, current := range p.PointSet {
+		if onSegment(&prev, &current, point) {
+			return false, true
+		}
+
+		if (prev[1] > point[1]) != (current[1] > point[1]) {
+			x := prev[0] + (point[1]-prev[1])*(current[0]-prev[0])/(current[1]-prev[1])
+			if point[0] < x {
+				inside = !inside
+			}
+		}
+
+		prev = current
+	}
+
+	return inside, false
+}
+
+// windingNumber returns the number of times the path, treated as a closed polygon,
+// winds counter clockwise around the point, or true if the point is on the boundary.
+func (p *Path) windingNumber(point *Point) (int, bool) {
+	if len(p.PointSet) < 3 {
+		return 0, false
+	}
+
+	winding := 0
+	prev := p.PointSet[len(p.PointSet)-1]
+	for _, current := range p.PointSet {
+		if onSegment(&prev, &current, point) {
+			return 0, true
+		}
+
+		// which side of the edge the point is on, positive for left
+		side := (current[0]-prev[0])*(point[1]-prev[1]) - (point[0]-prev[0])*(current[1]-prev[1])
+		if prev[1] <= point[1] && current[1] > point[1] && side > 0 {
+			winding++
+		} else if prev[1] > point[1] && current[1] <= point[1] && side < 0 {
+			winding--
+		}
+
+		prev = current
+	}
+
+	return winding, false
+}
+
+// onSegment returns true if the point lies exactly on the segment from a to b.
+func onSegment(a, b, point *Point) bool {
+	cross := (b[0]-a[0])*(point[1]-a[1]) - (b[1]-a[1])*(point[0]-a[0])
+	if cross != 0 {
+		return false
+	}
+
+	return math.Min(a[0], b[0]) <= point[0] && point[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= point[1] && point[1] <= math.Max(a[1], b[1])
+}
+
+// Area computes the signed area enclosed by the path, treated as a closed polygon,
+// using the shoelace formula. The result is positive for counter-clockwise paths,
+// consistent with Winding. Assumes a planar projection.
+func (p *Path) Area() float64 {
+	if len(p.PointSet) < 3 {
+		return 0
+	}
+
+	sum := 0.0
+	prev := p.PointSet[len(p.PointSet)-1]
+	for _, point := range p.PointSet {
+		sum += prev[0]*point[1] - point[0]*prev[1]
+		prev = point
+	}
+
+	return sum / 2.0
+}
+
+// Centroid returns the area weighted centroid of the polygon formed by the path,
+// treated as closed. Falls back to the average of the points, the PointSet centroid,
+// for degenerate, zero area, paths. Assumes a planar projection.
+func (p *Path) Centroid() *Point {
+	area := p.Area()
+	if area == 0 {
+		return p.PointSet.Centroid()
+	}
+
+	// offset by the first point to reduce floating point error
+	origin := p.PointSet[0]
+
+	x, y := 0.0, 0.0
+	prev := p.PointSet[len(p.PointSet)-1]
+	for _, point := range p.PointSet {
+		x1, y1 := prev[0]-origin[0], prev[1]-origin[1]
+		x2, y2 := point[0]-origin[0], point[1]-origin[1]
+
+		cross := x1*y2 - x2*y1
+		x += (x1 + x2) * cross
+		y += (y1 + y2) * cross
+
+		prev = point
+	}
+
+	return NewPoint(x/(6*area)+origin[0], y/(6*area)+origin[1])
+}
+
+// IsClockwise returns true if the path, treated as a closed polygon, is wound
+// clockwise, ie. has a negative Area. Use Reverse to normalize the winding order.
+// Degenerate, zero area, paths are not clockwise.
+func (p *Path) IsClockwise() bool {
+	return p.Area() < 0
+}
+
+// GeoArea computes the approximate signed area, in square meters, enclosed by
+// the path using the spherical excess of the polygon. The path must be in lng/lat
+// (EPSG:4326). Like Area the result is positive for counter-clockwise paths.
+func (p *Path) GeoArea() float64 {
+	if len(p.PointSet) < 3 {
+		return 0
+	}
+
+	sum := 0.0
+	prev := p.PointSet[len(p.PointSet)-1]
+	for _, point := range p.PointSet {
+		sum += deg2rad(point[0]-prev[0]) *
+			(2 + math.Sin(deg2rad(prev[1])) + math.Sin(deg2rad(point[1])))
+		prev = point
+	}
+
+	return -sum * EarthRadius * EarthRadius / 2.0
+}
+
+// Segments calls the function for each segment of the path, in order, with the index
+// of its first point. Iteration stops early if the function returns false.
+// The segments are passed by value so nothing is allocated.
+func (p *Path) Segments(f func(i int, segment Line) bool) {
 	for i := 0; i < len(p.PointSet)-1; i++ {
-		pTest := NewLine(&p.PointSet[i], &p.PointSet[i+1])
-		if pTest.Intersects(line) {
-			return true
+		if !f(i, Line{p.PointSet[i], p.PointSet[i+1]}) {
+			return
 		}
 	}
+}
 
-	return false
+// SegmentAt returns a new line of the segment from point i to i+1.
+// Panics if index is out of range.
+func (p *Path) SegmentAt(i int) *Line {
+	if i < 0 || i >= len(p.PointSet)-1 {
+		panic(fmt.Sprintf("geo: segment index out of range, requested: %d, segments: %d", i, len(p.PointSet)-1))
+	}
+
+	return &Line{p.PointSet[i], p.PointSet[i+1]}
 }
 
 // Bound returns a bound around the path. Uses rectangular coordinates.
+// Single point paths have a zero size bound around the point and empty paths
+// an empty bound at the origin. Paths crossing the antimeridian, with longitudes
+// in the standard -180 to 180 range, will get a bound spanning the whole globe,
+// use continuous longitudes, eg. 170 to 190, to get a tight bound.
 func (p *Path) Bound() *Bound {
 	if len(p.PointSet) == 0 {
 		return NewBound(0, 0, 0, 0)
@@ -480,6 +1578,7 @@ func (p *Path) Push(point *Point) *Path {
 }
 
 // Pop removes and returns the last point.
+// Returns nil if the path is empty.
 func (p *Path) Pop() *Point {
 	return (&p.PointSet).Pop()
 }
@@ -490,32 +1589,141 @@ func (p *Path) Length() int {
 }
 
 // Equals compares two paths. Returns true if lengths are the same
-// and all points are Equal.
+// and all points are Equal. Two nil paths are equal.
 func (p *Path) Equals(path *Path) bool {
+	if p == nil || path == nil {
+		return p == path
+	}
+
 	return (&p.PointSet).Equals(&path.PointSet)
 }
 
-// Clone returns a new copy of the path.
+// ApproxEquals compares two paths. Returns true if lengths are the same and
+// all points are within the tolerance of each other. If allowReversed is true
+// a path is also equal to its reverse. Two nil paths are equal.
+// Assumes euclidean geometry.
+func (p *Path) ApproxEquals(path *Path, tolerance float64, allowReversed ...bool) bool {
+	if p == nil || path == nil {
+		return p == path
+	}
+
+	if len(p.PointSet) != len(path.PointSet) {
+		return false
+	}
+
+	tolerance *= tolerance
+	equal := func(reverse bool) bool {
+		last := len(path.PointSet) - 1
+		for i := range p.PointSet {
+			j := i
+			if reverse {
+				j = last - i
+			}
+
+			if p.PointSet[i].SquaredDistanceFrom(&path.PointSet[j]) > tolerance {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if equal(false) {
+		return true
+	}
+
+	return len(allowReversed) != 0 && allowReversed[0] && equal(true)
+}
+
+// Clone returns a new, deep, copy of the path.
+// Modifying the clone will not affect the original.
 func (p *Path) Clone() *Path {
 	return &Path{*(&p.PointSet).Clone()}
 }
 
+// FlatCoords returns the points of the path as a flat slice
+// of [x0, y0, x1, y1, ...] values. NewPathFromFlatCoords does the reverse.
+func (p *Path) FlatCoords() []float64 {
+	coords := make([]float64, 0, 2*len(p.PointSet))
+	for _, point := range p.PointSet {
+		coords = append(coords, point[0], point[1])
+	}
+
+	return coords
+}
+
 // ToGeoJSON creates a new geojson feature with a linestring geometry
 // containing all the points.
 func (p *Path) ToGeoJSON() *geojson.Feature {
+	return geojson.NewFeature(p.ToGeoJSONGeometry())
+}
+
+// ToGeoJSONGeometry creates a new geojson linestring geometry containing
+// all the points in [lng, lat] order. NewPathFromGeoJSON does the reverse.
+func (p *Path) ToGeoJSONGeometry() *geojson.Geometry {
 	coords := make([][]float64, 0, len(p.PointSet))
 
 	for _, p := range p.PointSet {
 		coords = append(coords, []float64{p[0], p[1]})
 	}
 
-	return geojson.NewLineStringFeature(coords)
+	return geojson.NewLineStringGeometry(coords)
 }
 
-// ToWKT returns the path in WKT format, eg. LINESTRING(30 10,10 30,40 40)
-// For empty paths the result will be 'EMPTY'.
+// ToWKT returns the path in WKT format, eg. LINESTRING(30 10,10 30,40 40),
+// using the smallest number of digits needed to represent the values exactly.
+// Exponent notation is never used. Empty paths will be 'LINESTRING EMPTY'.
 func (p *Path) ToWKT() string {
-	return p.String()
+	return p.ToWKTWithOptions(WKTOptions{Precision: -1})
+}
+
+// WKTOptions control the output of Path.ToWKTWithOptions.
+type WKTOptions struct {
+	// Precision is the number of decimal places of the coordinates.
+	// Set to -1 to use the smallest number of digits needed to represent
+	// the values exactly. Exponent notation is never used.
+	Precision int
+
+	// AsMultiPoint outputs a MULTIPOINT, instead of a LINESTRING,
+	// which is useful for scatter data.
+	AsMultiPoint bool
+}
+
+// ToWKTWithOptions returns the path in WKT format, eg. LINESTRING(30 10,10 30,40 40),
+// using the given options. Unlike ToWKT, empty paths will be 'LINESTRING EMPTY'
+// or 'MULTIPOINT EMPTY'.
+func (p *Path) ToWKTWithOptions(opts WKTOptions) string {
+	geometry := "LINESTRING"
+	if opts.AsMultiPoint {
+		geometry = "MULTIPOINT"
+	}
+
+	if len(p.PointSet) == 0 {
+		return geometry + " EMPTY"
+	}
+
+	buff := bytes.NewBuffer(nil)
+	buff.WriteString(geometry)
+	buff.WriteByte('(')
+
+	precision := opts.Precision
+	if precision < 0 {
+		precision = -1
+	}
+
+	scratch := make([]byte, 0, 30)
+	for i, point := range p.PointSet {
+		if i != 0 {
+			buff.WriteByte(',')
+		}
+
+		buff.Write(strconv.AppendFloat(scratch, point[0], 'f', precision, 64))
+		buff.WriteByte(' ')
+		buff.Write(strconv.AppendFloat(scratch, point[1], 'f', precision, 64))
+	}
+
+	buff.WriteByte(')')
+	return buff.String()
 }
 
 // String returns a string representation of the path.
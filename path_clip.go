@@ -0,0 +1,101 @@
+package geo
+
+// Clip returns the portions of the path within the bound. A new path is started
+// each time the path exits and re-enters the bound, with interpolated points added
+// where the path crosses the boundary. Segments entirely outside contribute nothing
+// and a path entirely inside returns a single clone. The original path is not modified.
+// Uses the Liang-Barsky algorithm on each segment, assumes euclidean geometry.
+func (p *Path) Clip(bound *Bound) []*Path {
+	var result []*Path
+
+	if len(p.PointSet) == 0 {
+		return result
+	}
+
+	inside := true
+	for i := range p.PointSet {
+		if !bound.Contains(&p.PointSet[i]) {
+			inside = false
+			break
+		}
+	}
+
+	if inside {
+		return append(result, p.Clone())
+	}
+
+	var current *Path
+	p.Segments(func(i int, segment Line) bool {
+		a, b, ok := clipSegment(segment.a, segment.b, bound)
+
+		// segments just touching the bound are skipped
+		if !ok || (a == b && segment.a != segment.b) {
+			current = nil
+			return true
+		}
+
+		if current == nil {
+			current = NewPath()
+			current.PointSet = append(current.PointSet, a)
+			result = append(result, current)
+		}
+
+		current.PointSet = append(current.PointSet, b)
+
+		// the path leaves the bound, start a new path if it comes back
+		if b != segment.b {
+			current = nil
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// clipSegment clips the segment from a to b to the bound using the Liang-Barsky
+// algorithm. Returns false if the segment is entirely outside the bound.
+func clipSegment(a, b Point, bound *Bound) (Point, Point, bool) {
+	dx := b[0] - a[0]
+	dy := b[1] - a[1]
+
+	p := [4]float64{-dx, dx, -dy, dy}
+	q := [4]float64{a[0] - bound.sw[0], bound.ne[0] - a[0], a[1] - bound.sw[1], bound.ne[1] - a[1]}
+
+	t0, t1 := 0.0, 1.0
+	for i := 0; i < 4; i++ {
+		if p[i] == 0 {
+			// parallel to this edge
+			if q[i] < 0 {
+				return a, b, false
+			}
+			continue
+		}
+
+		r := q[i] / p[i]
+		if p[i] < 0 {
+			if r > t1 {
+				return a, b, false
+			} else if r > t0 {
+				t0 = r
+			}
+		} else {
+			if r < t0 {
+				return a, b, false
+			} else if r < t1 {
+				t1 = r
+			}
+		}
+	}
+
+	clippedA, clippedB := a, b
+	if t0 > 0 {
+		clippedA = Point{a[0] + t0*dx, a[1] + t0*dy}
+	}
+
+	if t1 < 1 {
+		clippedB = Point{a[0] + t1*dx, a[1] + t1*dy}
+	}
+
+	return clippedA, clippedB, true
+}
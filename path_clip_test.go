@@ -0,0 +1,71 @@
+package geo
+
+import "testing"
+
+func TestPathClip(t *testing.T) {
+	bound := NewBound(0, 10, 0, 10)
+
+	if paths := NewPath().Clip(bound); len(paths) != 0 {
+		t.Errorf("path, clip of empty path should be empty, got %v", paths)
+	}
+
+	// entirely inside
+	p := NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)).Push(NewPoint(9, 1))
+	paths := p.Clip(bound)
+	if len(paths) != 1 || !paths[0].Equals(p) || paths[0] == p {
+		t.Errorf("path, clip of inside path should return a clone, got %v", paths)
+	}
+
+	// entirely outside
+	p = NewPath().Push(NewPoint(11, 1)).Push(NewPoint(15, 5)).Push(NewPoint(11, 9))
+	if paths := p.Clip(bound); len(paths) != 0 {
+		t.Errorf("path, clip of outside path should be empty, got %v", paths)
+	}
+
+	// crosses the same edge multiple times
+	p = NewPath().
+		Push(NewPoint(5, 5)).
+		Push(NewPoint(15, 5)).
+		Push(NewPoint(15, 7)).
+		Push(NewPoint(5, 7)).
+		Push(NewPoint(5, 9)).
+		Push(NewPoint(15, 9))
+
+	expected := []*Path{
+		NewPath().Push(NewPoint(5, 5)).Push(NewPoint(10, 5)),
+		NewPath().Push(NewPoint(10, 7)).Push(NewPoint(5, 7)).Push(NewPoint(5, 9)).Push(NewPoint(10, 9)),
+	}
+
+	paths = p.Clip(bound)
+	if len(paths) != len(expected) {
+		t.Fatalf("path, clip expected %v, got %v", expected, paths)
+	}
+
+	for i := range expected {
+		if !paths[i].Equals(expected[i]) {
+			t.Errorf("path, clip expected %v, got %v", expected[i], paths[i])
+		}
+	}
+
+	// touches a corner
+	p = NewPath().Push(NewPoint(-2, 8)).Push(NewPoint(4, 14))
+	paths = p.Clip(bound)
+
+	if len(paths) != 0 {
+		t.Errorf("path, clip of line touching corner should be empty, got %v", paths)
+	}
+
+	// cuts across a corner with no vertex inside
+	p = NewPath().Push(NewPoint(-2, 7)).Push(NewPoint(2, 11))
+	paths = p.Clip(bound)
+
+	expected = []*Path{NewPath().Push(NewPoint(0, 9)).Push(NewPoint(1, 10))}
+	if len(paths) != 1 || !paths[0].Equals(expected[0]) {
+		t.Errorf("path, clip across corner expected %v, got %v", expected, paths)
+	}
+
+	// original should not be modified
+	if p.Length() != 2 || !p.GetAt(0).Equals(NewPoint(-2, 7)) {
+		t.Errorf("path, clip should not modify the original, got %v", p)
+	}
+}
@@ -0,0 +1,250 @@
+package geo
+
+import "math"
+
+// offsetMiterLimit is the maximum ratio of the miter length to the offset distance,
+// same as the SVG stroke-miterlimit default. Sharper corners are beveled.
+const offsetMiterLimit = 4.0
+
+// Offset replaces the path with a parallel path the given distance to the right
+// of the direction of travel, negative values offset to the left.
+// Corners are joined with a miter, falling back to a bevel for sharp corners.
+// Rings stay rings. Paths without two distinct points are not changed.
+// Assumes euclidean geometry. Use p.Clone().Offset(d) to keep the original.
+func (p *Path) Offset(distance float64) *Path {
+	// consecutive duplicates have no direction
+	ps := p.Clone().RemoveDuplicates().PointSet
+
+	if len(ps) < 2 {
+		return p
+	}
+
+	normals := segmentNormals(ps)
+
+	result := make(PointSet, 0, 2*len(ps))
+	if closed := len(ps) > 2 && ps[0] == ps[len(ps)-1]; closed {
+		join := offsetJoin(nil, &ps[0], &normals[len(normals)-1], &normals[0], distance)
+
+		result = append(result, join[len(join)-1])
+		for i := 1; i < len(ps)-1; i++ {
+			result = offsetJoin(result, &ps[i], &normals[i-1], &normals[i], distance)
+		}
+		result = append(result, join...)
+	} else {
+		result = append(result, Point{ps[0][0] + distance*normals[0][0], ps[0][1] + distance*normals[0][1]})
+		for i := 1; i < len(ps)-1; i++ {
+			result = offsetJoin(result, &ps[i], &normals[i-1], &normals[i], distance)
+		}
+
+		last, n := &ps[len(ps)-1], &normals[len(normals)-1]
+		result = append(result, Point{last[0] + distance*n[0], last[1] + distance*n[1]})
+	}
+
+	p.PointSet = result
+	return p
+}
+
+// GeoOffset replaces the path with a parallel path the given number of meters
+// to the right of the direction of travel, negative values offset to the left.
+// The offset is computed in a local equirectangular projection so the
+// path must be in lng/lat (EPSG:4326) and not span too large an area.
+// Use p.Clone().GeoOffset(meters) to keep the original.
+func (p *Path) GeoOffset(meters float64) *Path {
+	if len(p.PointSet) == 0 {
+		return p
+	}
+
+	projection := localProjection(p.PointSet)
+
+	p.Transform(projection.Project)
+	p.Offset(meters)
+	p.Transform(projection.Inverse)
+
+	return p
+}
+
+// Buffer replaces the path with a ring enclosing the area within the given
+// distance of the path. Both sides are offset with round joins on the outside
+// of turns and the ends are closed by semicircles. Semicircles are approximated
+// by arcSegments segments. The ring is counter clockwise and self intersects
+// where the path comes within twice the distance of itself, Contains treats those
+// overlaps as outside, see BufferPolygon. A single point becomes a circle.
+// Assumes euclidean geometry. Use p.Clone().Buffer(d, n) to keep the original.
+func (p *Path) Buffer(distance float64, arcSegments int) *Path {
+	distance = math.Abs(distance)
+	if len(p.PointSet) == 0 || distance == 0 {
+		return p
+	}
+
+	if arcSegments < 1 {
+		arcSegments = 1
+	}
+	step := math.Pi / float64(arcSegments)
+
+	ps := p.Clone().RemoveDuplicates().PointSet
+
+	if len(ps) == 1 {
+		result := make(PointSet, 0, 2*arcSegments+1)
+		result = append(result, Point{ps[0][0] + distance, ps[0][1]})
+		result = appendArc(result, &ps[0], &Point{1, 0}, 2*math.Pi, distance, step)
+		p.PointSet = append(result, result[0])
+
+		return p
+	}
+
+	// right hand normals going forward, and backward
+	normals := segmentNormals(ps)
+
+	reversed := make(PointSet, len(ps))
+	for i := range ps {
+		reversed[i] = ps[len(ps)-1-i]
+	}
+
+	reversedNormals := make([]Point, len(normals))
+	for i := range normals {
+		n := normals[len(normals)-1-i]
+		reversedNormals[i] = Point{-n[0], -n[1]}
+	}
+
+	result := make(PointSet, 0, 4*len(ps)+2*arcSegments)
+
+	result = bufferSide(result, ps, normals, distance, step)
+	result = appendArc(result, &ps[len(ps)-1], &normals[len(normals)-1], math.Pi, distance, step)
+
+	result = bufferSide(result, reversed, reversedNormals, distance, step)
+	result = appendArc(result, &ps[0], &reversedNormals[len(reversedNormals)-1], math.Pi, distance, step)
+
+	p.PointSet = append(result, result[0])
+	return p
+}
+
+// GeoBuffer replaces the path with a ring enclosing the area within the given
+// number of meters of the path, see Buffer. The buffer is computed in a local
+// equirectangular projection so the path must be in lng/lat (EPSG:4326)
+// and not span too large an area.
+// Use p.Clone().GeoBuffer(meters, n) to keep the original.
+func (p *Path) GeoBuffer(meters float64, arcSegments int) *Path {
+	if len(p.PointSet) == 0 {
+		return p
+	}
+
+	projection := localProjection(p.PointSet)
+
+	p.Transform(projection.Project)
+	p.Buffer(meters, arcSegments)
+	p.Transform(projection.Inverse)
+
+	return p
+}
+
+// BufferPolygon returns a new polygon of the area within the given distance
+// of the path, see Buffer. Polygon.Contains fills the ring using the non-zero
+// winding rule so overlaps, where the path comes close to itself, are inside.
+// The path is not modified. Assumes euclidean geometry.
+func (p *Path) BufferPolygon(distance float64, arcSegments int) *Polygon {
+	return NewPolygon(p.Clone().Buffer(distance, arcSegments))
+}
+
+// GeoBufferPolygon returns a new polygon of the area within the given number
+// of meters of the lng/lat path, see GeoBuffer and BufferPolygon.
+// The path is not modified.
+func (p *Path) GeoBufferPolygon(meters float64, arcSegments int) *Polygon {
+	return NewPolygon(p.Clone().GeoBuffer(meters, arcSegments))
+}
+
+// bufferSide appends the offset of the right side of the points. Turns to the
+// left get a round join, turns to the right the same join as Offset.
+func bufferSide(result, ps PointSet, normals []Point, distance, step float64) PointSet {
+	result = append(result, Point{ps[0][0] + distance*normals[0][0], ps[0][1] + distance*normals[0][1]})
+
+	for i := 1; i < len(ps)-1; i++ {
+		n1, n2 := &normals[i-1], &normals[i]
+
+		cross := n1[0]*n2[1] - n1[1]*n2[0]
+		cos := n1[0]*n2[0] + n1[1]*n2[1]
+
+		if cross > 0 || (cross == 0 && cos < 0) {
+			result = append(result, Point{ps[i][0] + distance*n1[0], ps[i][1] + distance*n1[1]})
+			result = appendArc(result, &ps[i], n1, math.Atan2(math.Abs(cross), cos), distance, step)
+			result = append(result, Point{ps[i][0] + distance*n2[0], ps[i][1] + distance*n2[1]})
+		} else {
+			result = offsetJoin(result, &ps[i], n1, n2, distance)
+		}
+	}
+
+	last, n := &ps[len(ps)-1], &normals[len(normals)-1]
+	return append(result, Point{last[0] + distance*n[0], last[1] + distance*n[1]})
+}
+
+// appendArc appends the points strictly between the start and end of
+// a counter clockwise arc around the center, starting in the direction
+// of the unit vector, using segments of at most the step angle.
+func appendArc(ps PointSet, center, from *Point, angle, distance, step float64) PointSet {
+	count := int(math.Ceil(angle/step - epsilon))
+	for i := 1; i < count; i++ {
+		sin, cos := math.Sincos(angle * float64(i) / float64(count))
+		ps = append(ps, Point{
+			center[0] + distance*(from[0]*cos-from[1]*sin),
+			center[1] + distance*(from[0]*sin+from[1]*cos),
+		})
+	}
+
+	return ps
+}
+
+// segmentNormals returns the right hand unit normal of each segment.
+// The points must not have consecutive duplicates.
+func segmentNormals(ps PointSet) []Point {
+	normals := make([]Point, len(ps)-1)
+	for i := range normals {
+		dx := ps[i+1][0] - ps[i][0]
+		dy := ps[i+1][1] - ps[i][1]
+		l := math.Hypot(dx, dy)
+
+		normals[i] = Point{dy / l, -dx / l}
+	}
+
+	return normals
+}
+
+// offsetJoin appends the offset points at a vertex, given the normals of the
+// segments before and after. A single miter point, or two points if beveled.
+func offsetJoin(ps PointSet, vertex, n1, n2 *Point, distance float64) PointSet {
+	// cos of the change in direction
+	cos := n1[0]*n2[0] + n1[1]*n2[1]
+
+	// miter length / distance = 1/cos(angle/2) and cos(angle/2)^2 = (1+cos)/2
+	if 1+cos < 2/(offsetMiterLimit*offsetMiterLimit) {
+		return append(ps,
+			Point{vertex[0] + distance*n1[0], vertex[1] + distance*n1[1]},
+			Point{vertex[0] + distance*n2[0], vertex[1] + distance*n2[1]},
+		)
+	}
+
+	f := distance / (1 + cos)
+	return append(ps, Point{vertex[0] + f*(n1[0]+n2[0]), vertex[1] + f*(n1[1]+n2[1])})
+}
+
+// localProjection returns an equirectangular projection, in meters, centered
+// at the mean latitude of the points. Good for small areas away from the poles.
+func localProjection(ps PointSet) Projection {
+	lat := 0.0
+	for i := range ps {
+		lat += ps[i][1]
+	}
+	lat /= float64(len(ps))
+
+	factor := math.Pi / 180 * EarthRadius
+	cos := math.Cos(deg2rad(lat))
+
+	return Projection{
+		Project: func(p *Point) {
+			p[0] *= factor * cos
+			p[1] *= factor
+		},
+		Inverse: func(p *Point) {
+			p[0] /= factor * cos
+			p[1] /= factor
+		},
+	}
+}
@@ -0,0 +1,303 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPathOffset(t *testing.T) {
+	cases := []struct {
+		name     string
+		path     *Path
+		distance float64
+		expected *Path
+	}{
+		{
+			name:     "straight right",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)),
+			distance: 1,
+			expected: NewPath().Push(NewPoint(0, -1)).Push(NewPoint(10, -1)),
+		},
+		{
+			name:     "straight left",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)),
+			distance: -1,
+			expected: NewPath().Push(NewPoint(0, 1)).Push(NewPoint(10, 1)),
+		},
+		{
+			name:     "collinear",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(5, 0)).Push(NewPoint(10, 0)),
+			distance: 1,
+			expected: NewPath().Push(NewPoint(0, -1)).Push(NewPoint(5, -1)).Push(NewPoint(10, -1)),
+		},
+		{
+			name:     "outside miter",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 10)),
+			distance: 1,
+			expected: NewPath().Push(NewPoint(0, -1)).Push(NewPoint(11, -1)).Push(NewPoint(11, 10)),
+		},
+		{
+			name:     "inside miter",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 10)),
+			distance: -1,
+			expected: NewPath().Push(NewPoint(0, 1)).Push(NewPoint(9, 1)).Push(NewPoint(9, 10)),
+		},
+		{
+			name:     "duplicates",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 0)),
+			distance: 1,
+			expected: NewPath().Push(NewPoint(0, -1)).Push(NewPoint(10, -1)),
+		},
+		{
+			name:     "ring",
+			path:     NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 10)).Push(NewPoint(0, 10)).Push(NewPoint(0, 0)),
+			distance: 1,
+			expected: NewPath().Push(NewPoint(-1, -1)).Push(NewPoint(11, -1)).Push(NewPoint(11, 11)).Push(NewPoint(-1, 11)).Push(NewPoint(-1, -1)),
+		},
+	}
+
+	for _, tc := range cases {
+		p := tc.path.Clone().Offset(tc.distance)
+		if !p.ApproxEquals(tc.expected, 1e-9) {
+			t.Errorf("path, offset %s: expected %v, got %v", tc.name, tc.expected, p)
+		}
+	}
+
+	// not enough distinct points
+	for _, p := range []*Path{NewPath(), NewPath().Push(NewPoint(1, 1)), NewPath().Push(NewPoint(1, 1)).Push(NewPoint(1, 1))} {
+		expected := p.Clone()
+		if !p.Offset(1).Equals(expected) {
+			t.Errorf("path, offset should not change path without direction, got %v", p)
+		}
+	}
+}
+
+func TestPathOffsetBevel(t *testing.T) {
+	// hairpin turn, the miter would be very long
+	vertex := NewPoint(10, 0)
+	p := NewPath().Push(NewPoint(0, 0)).Push(vertex).Push(NewPoint(0, 1))
+
+	p.Offset(1)
+	if p.Length() != 4 {
+		t.Fatalf("path, offset hairpin should be beveled, got %v", p)
+	}
+
+	for i := 1; i < 3; i++ {
+		if d := p.GetAt(i).DistanceFrom(vertex); math.Abs(d-1) > 1e-9 {
+			t.Errorf("path, offset bevel point should be distance 1 from vertex, got %f", d)
+		}
+	}
+
+	// ring with a sharp start vertex
+	p = NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 1)).Push(NewPoint(10, -1)).Push(NewPoint(0, 0))
+	p.Offset(0.1)
+
+	if !p.IsRing() || p.Length() != 5 {
+		t.Fatalf("path, offset ring with bevel at start should be a ring of 5 points, got %v", p)
+	}
+
+	for _, i := range []int{0, 3} {
+		if d := p.GetAt(i).DistanceFrom(NewPoint(0, 0)); math.Abs(d-0.1) > 1e-9 {
+			t.Errorf("path, offset ring bevel point should be distance 0.1 from start, got %f", d)
+		}
+	}
+}
+
+func TestPathGeoOffset(t *testing.T) {
+	// heading north, right is east
+	p := NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(NewPoint(-122.4, 37.7+0.001*float64(i)))
+	}
+
+	offset := p.Clone().GeoOffset(100)
+	if offset.Length() != p.Length() {
+		t.Fatalf("path, geo offset should have same number of points, got %d", offset.Length())
+	}
+
+	for i := range p.PointSet {
+		o := offset.GetAt(i)
+		if o.Lng() <= p.GetAt(i).Lng() {
+			t.Errorf("path, geo offset should be to the east, got %v", o)
+		}
+
+		if d := o.GeoDistanceFrom(p.GetAt(i)); math.Abs(d-100) > 0.5 {
+			t.Errorf("path, geo offset should be 100 meters, got %f", d)
+		}
+	}
+
+	if !NewPath().GeoOffset(100).Equals(NewPath()) {
+		t.Error("path, geo offset of empty path should be empty")
+	}
+}
+
+func TestPathBuffer(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0))
+	p.Buffer(1, 4)
+
+	if !p.IsRing() || p.Length() != 11 {
+		t.Fatalf("path, buffer of segment should be ring of 11 points, got %v", p)
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(0, -1)) || !p.GetAt(1).Equals(NewPoint(10, -1)) {
+		t.Errorf("path, buffer should start with right side, got %v", p)
+	}
+
+	// rectangle and two halves of an octagon
+	if a := p.Area(); math.Abs(a-(20+2*math.Sqrt2)) > 1e-9 {
+		t.Errorf("path, buffer area incorrect, got %f", a)
+	}
+
+	// single point
+	p = NewPath().Push(NewPoint(1, 1)).Push(NewPoint(1, 1))
+	p.Buffer(2, 3)
+
+	if !p.IsRing() || p.Length() != 7 {
+		t.Fatalf("path, buffer of point should be hexagon, got %v", p)
+	}
+
+	for i := range p.PointSet {
+		if d := p.GetAt(i).DistanceFrom(NewPoint(1, 1)); math.Abs(d-2) > 1e-9 {
+			t.Errorf("path, buffer of point should be distance 2 from point, got %f", d)
+		}
+	}
+
+	if !NewPath().Buffer(1, 8).Equals(NewPath()) {
+		t.Error("path, buffer of empty path should be empty")
+	}
+}
+
+func TestPathBufferContains(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	// left and right turns, some sharp
+	path := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(10, 0)).
+		Push(NewPoint(10, 10)).
+		Push(NewPoint(20, 12)).
+		Push(NewPoint(20, 2)).
+		Push(NewPoint(14, 2))
+
+	distance := 1.5
+	buffer := path.Clone().Buffer(distance, 16)
+
+	if buffer.Area() <= 0 {
+		t.Errorf("path, buffer should be counter clockwise")
+	}
+
+	// the arcs are chords so points near the edge may be outside
+	inner := distance * math.Cos(math.Pi/16/2)
+	for i := 0; i < 2000; i++ {
+		point := NewPoint(25*r.Float64()-2, 16*r.Float64()-2)
+		d := path.DistanceFrom(point)
+
+		if d < inner-epsilon && !buffer.Contains(point) {
+			t.Errorf("path, buffer should contain %v at distance %f", point, d)
+		}
+
+		if d > distance+epsilon && buffer.Contains(point) {
+			t.Errorf("path, buffer should not contain %v at distance %f", point, d)
+		}
+	}
+}
+
+func TestPathGeoBuffer(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(NewPoint(-122.4, 37.7+0.001*float64(i)))
+	}
+
+	buffer := p.Clone().GeoBuffer(100, 8)
+	if !buffer.IsRing() {
+		t.Fatalf("path, geo buffer should be a ring")
+	}
+
+	middle := p.GetAt(2)
+	for _, test := range []struct {
+		meters   float64
+		contains bool
+	}{
+		{90, true},
+		{-90, true},
+		{110, false},
+		{-110, false},
+	} {
+		// move east or west the given number of meters
+		point := middle.Clone()
+		point.SetLng(point.Lng() + rad2deg(test.meters/EarthRadius)/math.Cos(deg2rad(point.Lat())))
+
+		if buffer.Contains(point) != test.contains {
+			t.Errorf("path, geo buffer contains %f meters should be %v", test.meters, test.contains)
+		}
+	}
+}
+
+func TestPathGeoBufferPolygon(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.42, 37.77)).
+		Push(NewPoint(-122.40, 37.77))
+
+	b := p.GeoBufferPolygon(500, 8)
+	if p.Length() != 2 {
+		t.Errorf("path, buffer polygon should not modify path")
+	}
+
+	// meters in degrees, north and east
+	lat := func(m float64) float64 { return m / EarthRadius * 180 / math.Pi }
+	lng := func(m float64) float64 { return lat(m) / math.Cos(deg2rad(37.77)) }
+
+	cases := []struct {
+		name     string
+		point    *Point
+		expected bool
+	}{
+		{"on path", NewPoint(-122.41, 37.77), true},
+		{"inside north", NewPoint(-122.41, 37.77+lat(490)), true},
+		{"outside north", NewPoint(-122.41, 37.77+lat(510)), false},
+		{"inside south", NewPoint(-122.41, 37.77-lat(490)), true},
+		{"outside south", NewPoint(-122.41, 37.77-lat(510)), false},
+		{"inside beyond end", NewPoint(-122.40+lng(490), 37.77), true},
+		{"outside beyond end", NewPoint(-122.40+lng(510), 37.77), false},
+		{"inside before start", NewPoint(-122.42-lng(490), 37.77), true},
+		{"outside before start", NewPoint(-122.42-lng(510), 37.77), false},
+		{"inside end diagonal", NewPoint(-122.40+lng(330), 37.77+lat(330)), true},
+		{"outside end diagonal", NewPoint(-122.40+lng(360), 37.77+lat(360)), false},
+	}
+
+	for _, tc := range cases {
+		if v := b.Contains(tc.point); v != tc.expected {
+			t.Errorf("path, buffer polygon contains %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+	}
+}
+
+func TestPathBufferPolygonOverlaps(t *testing.T) {
+	// out and back, the sides overlap
+	p := NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {0, 0.1}})
+
+	ring := p.Clone().Buffer(1, 8)
+	b := p.BufferPolygon(1, 8)
+
+	for _, point := range []*Point{NewPoint(5, 0.05), NewPoint(5, 0.5), NewPoint(5, -0.5), NewPoint(10.5, 0)} {
+		if !b.Contains(point) {
+			t.Errorf("path, buffer polygon should contain %v", point)
+		}
+	}
+
+	// overlaps are outside with the even-odd rule of Path.Contains
+	if ring.Contains(NewPoint(5, 0.05)) {
+		t.Errorf("path, buffer ring should not contain overlap")
+	}
+
+	for _, point := range []*Point{NewPoint(5, 1.5), NewPoint(5, -1.5), NewPoint(-1.5, 0), NewPoint(11.5, 0)} {
+		if b.Contains(point) {
+			t.Errorf("path, buffer polygon should not contain %v", point)
+		}
+	}
+
+	if b := NewPath().BufferPolygon(1, 8); b.Contains(NewPoint(0, 0)) {
+		t.Errorf("path, buffer polygon of empty path should be empty")
+	}
+}
@@ -18,6 +18,25 @@ func (p *Path) Resample(totalPoints int) *Path {
 	return p
 }
 
+// GeoResample converts the path into totalPoints-1 evenly spaced segments.
+// The spacing is computed using spherical (lng/lat) geometry, but the new points
+// are chosen by linearly interpolating between two given points.
+func (p *Path) GeoResample(totalPoints int) *Path {
+	if totalPoints <= 0 {
+		p.PointSet = make([]Point, 0)
+		return p
+	}
+
+	if p.resampleEdgeCases(totalPoints) {
+		return p
+	}
+
+	// precomputes the total geo distance and intermediate distances
+	total, dists := precomputeGeoDistances(p.PointSet)
+	p.resample(dists, total, totalPoints)
+	return p
+}
+
 // ResampleWithInterval coverts the path into evenly spaced points of
 // about the given distance. The total distance is computed using euclidean
 // geometry and then divided by the given distance to get the number of segments.
@@ -51,12 +70,7 @@ func (p *Path) ResampleWithGeoInterval(meters float64) *Path {
 	}
 
 	// precomputes the total geo distance and intermediate distances
-	totalDistance := 0.0
-	distances := make([]float64, len(p.PointSet)-1)
-	for i := 0; i < len(p.PointSet)-1; i++ {
-		distances[i] = p.PointSet[i].GeoDistanceFrom(&p.PointSet[i+1])
-		totalDistance += distances[i]
-	}
+	totalDistance, distances := precomputeGeoDistances(p.PointSet)
 
 	totalPoints := int(totalDistance/meters) + 1
 	if p.resampleEdgeCases(totalPoints) {
@@ -67,6 +81,104 @@ func (p *Path) ResampleWithGeoInterval(meters float64) *Path {
 	return p
 }
 
+// A ResampleRemainder defines what to do with the final partial interval
+// when resampling with a fixed interval.
+type ResampleRemainder int
+
+const (
+	// ResampleStretch stretches the interval so the points are evenly spaced
+	// from start to end. This is the behavior of ResampleWithInterval.
+	ResampleStretch ResampleRemainder = iota
+
+	// ResampleDrop drops the final partial interval, so the path
+	// will end on the last whole interval.
+	ResampleDrop
+
+	// ResampleKeepEnd keeps the original end point, so the last
+	// segment will be shorter than the interval.
+	ResampleKeepEnd
+)
+
+// ResampleWithFixedInterval places points every dist along the path, starting at
+// the first point. The remainder defines what to do with the final partial interval.
+// Assumes euclidean geometry.
+func (p *Path) ResampleWithFixedInterval(dist float64, remainder ResampleRemainder) *Path {
+	if remainder == ResampleStretch {
+		return p.ResampleWithInterval(dist)
+	}
+
+	if dist <= 0 {
+		p.PointSet = make([]Point, 0)
+		return p
+	}
+
+	if len(p.PointSet) <= 1 {
+		return p
+	}
+
+	total, dists := precomputeDistances(p.PointSet)
+	p.resampleFixed(dists, total, dist, remainder)
+	return p
+}
+
+// ResampleWithFixedGeoInterval places points every given number of meters along the path,
+// starting at the first point. The distances are computed using spherical (lng/lat) geometry
+// and the new points are linearly interpolated between the given points.
+// The remainder defines what to do with the final partial interval.
+func (p *Path) ResampleWithFixedGeoInterval(meters float64, remainder ResampleRemainder) *Path {
+	if remainder == ResampleStretch {
+		return p.ResampleWithGeoInterval(meters)
+	}
+
+	if meters <= 0 {
+		p.PointSet = make([]Point, 0)
+		return p
+	}
+
+	if len(p.PointSet) <= 1 {
+		return p
+	}
+
+	total, dists := precomputeGeoDistances(p.PointSet)
+	p.resampleFixed(dists, total, meters, remainder)
+	return p
+}
+
+func (p *Path) resampleFixed(distances []float64, totalDistance, interval float64, remainder ResampleRemainder) {
+	points := make([]Point, 1, int(totalDistance/interval)+2)
+	points[0] = p.PointSet[0] // start stays the same
+
+	distance := 0.0
+	currentDistance := interval
+	for i := 0; i < len(p.PointSet)-1; i++ {
+		a := p.PointSet[i]
+		b := p.PointSet[i+1]
+
+		currentLineDistance := distances[i]
+		nextDistance := distance + currentLineDistance
+
+		for currentDistance <= nextDistance {
+			percent := (currentDistance - distance) / currentLineDistance
+			points = append(points, Point{
+				a[0] + percent*(b[0]-a[0]),
+				a[1] + percent*(b[1]-a[1]),
+			})
+
+			// multiply, vs. add, to not accumulate round off errors
+			currentDistance = interval * float64(len(points))
+		}
+
+		distance = nextDistance
+	}
+
+	last := p.PointSet[len(p.PointSet)-1]
+	if remainder == ResampleKeepEnd && !points[len(points)-1].Equals(&last) {
+		points = append(points, last)
+	}
+
+	(&p.PointSet).SetPoints(points)
+}
+
 func (p *Path) resample(distances []float64, totalDistance float64, totalPoints int) {
 	points := make([]Point, 1, totalPoints)
 	points[0] = p.PointSet[0] // start stays the same
@@ -151,6 +263,10 @@ func (p *Path) resampleEdgeCases(totalPoints int) bool {
 
 // precomputeDistances precomputes the total distance and intermediate distances.
 func precomputeDistances(p PointSet) (float64, []float64) {
+	if len(p) < 2 {
+		return 0, nil
+	}
+
 	total := 0.0
 	dists := make([]float64, len(p)-1)
 	for i := 0; i < len(p)-1; i++ {
@@ -160,3 +276,19 @@ func precomputeDistances(p PointSet) (float64, []float64) {
 
 	return total, dists
 }
+
+// precomputeGeoDistances precomputes the total geo distance and intermediate geo distances.
+func precomputeGeoDistances(p PointSet) (float64, []float64) {
+	if len(p) < 2 {
+		return 0, nil
+	}
+
+	total := 0.0
+	dists := make([]float64, len(p)-1)
+	for i := 0; i < len(p)-1; i++ {
+		dists[i] = p[i].GeoDistanceFrom(&p[i+1])
+		total += dists[i]
+	}
+
+	return total, dists
+}
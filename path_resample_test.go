@@ -1,6 +1,10 @@
 package geo
 
-import "testing"
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
 
 func TestPathResample(t *testing.T) {
 	p := NewPath()
@@ -65,8 +69,111 @@ func TestPathResample(t *testing.T) {
 	}
 }
 
+func TestPathResampleZeroLengthSegments(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(2, 0))
+
+	p.Resample(5)
+	answer := NewPath()
+	answer.Push(NewPoint(0, 0)).Push(NewPoint(0.5, 0))
+	answer.Push(NewPoint(1, 0)).Push(NewPoint(1.5, 0))
+	answer.Push(NewPoint(2, 0))
+	if !p.Equals(answer) {
+		t.Errorf("path, resample with zero length segments incorrect, got %v", p)
+	}
+
+	// closed loop
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(0, 1))
+	p.Push(NewPoint(0, 0))
+
+	p.Resample(9)
+	if l := p.Length(); l != 9 {
+		t.Errorf("path, resample loop incorrect length, got %d", l)
+	}
+
+	if !p.GetAt(0).Equals(p.GetAt(8)) {
+		t.Errorf("path, resample loop should stay closed, got %v", p)
+	}
+
+	if v := p.GetAt(4); !v.Equals(NewPoint(1, 1)) {
+		t.Errorf("path, resample loop incorrect point, got %v", v)
+	}
+}
+
+func TestPathResampleDistance(t *testing.T) {
+	// the resampled path cuts corners, but it should not lose
+	// more than one interval of length per corner.
+	for loop := 0; loop < 100; loop++ {
+		p := NewPath()
+		for i := 0; i < 10; i++ {
+			p.Push(NewPoint(rand.Float64(), rand.Float64()))
+		}
+
+		totalPoints := 1000 + rand.Intn(1000)
+		original := p.Distance()
+		maxLoss := original / float64(totalPoints-1) * float64(p.Length()-2)
+
+		p.Resample(totalPoints)
+		if l := p.Length(); l != totalPoints {
+			t.Fatalf("path, resample incorrect length, expected %d, got %d", totalPoints, l)
+		}
+
+		if d := p.Distance(); math.Abs(d-original) > maxLoss {
+			t.Errorf("path, resample length off by more than an interval per corner, %f != %f", d, original)
+		}
+	}
+}
+
+func TestPathGeoResample(t *testing.T) {
+	p := NewPath()
+	p.GeoResample(10) // should not panic
+
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 10))
+	p.Push(NewPoint(0, 20))
+
+	if l := p.Clone().GeoResample(1).Length(); l != 1 {
+		t.Errorf("path, geo resample down to 1 should be first point, got %d", l)
+	}
+
+	if l := p.Clone().GeoResample(0).Length(); l != 0 {
+		t.Errorf("path, geo resample down to 0 should be empty, got %d", l)
+	}
+
+	p.GeoResample(5)
+	if l := p.Length(); l != 5 {
+		t.Errorf("path, geo resample incorrect length, got %d", l)
+	}
+
+	for i := 0; i < p.Length(); i++ {
+		if v := p.GetAt(i); math.Abs(v.Lat()-5*float64(i)) > epsilon {
+			t.Errorf("path, geo resample incorrect point, got %v", v)
+		}
+	}
+
+	// longitude is shorter at higher latitudes
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 60))
+	p.Push(NewPoint(10, 60))
+
+	p.GeoResample(3)
+	if v := p.GetAt(1); v.Lng() != 0 || v.Lat() > 60 {
+		t.Errorf("path, geo resample should be on first segment, got %v", v)
+	}
+}
+
 func TestPathResampleWithInterval(t *testing.T) {
 	p := NewPath()
+	p.ResampleWithInterval(5.0) // should not panic
+
 	p.Push(NewPoint(0, 0))
 	p.Push(NewPoint(0, 10))
 
@@ -82,6 +189,8 @@ func TestPathResampleWithInterval(t *testing.T) {
 
 func TestPathResampleWithGeoInterval(t *testing.T) {
 	p := NewPath()
+	p.ResampleWithGeoInterval(5.0) // should not panic
+
 	p.Push(NewPoint(0, 0))
 	p.Push(NewPoint(0, 10))
 
@@ -96,6 +205,95 @@ func TestPathResampleWithGeoInterval(t *testing.T) {
 	}
 }
 
+func TestPathResampleWithFixedInterval(t *testing.T) {
+	p := NewPath()
+	p.ResampleWithFixedInterval(1, ResampleDrop) // should not panic
+
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 4))
+	p.Push(NewPoint(3, 4))
+
+	// lands exactly on the vertices
+	result := p.Clone().ResampleWithFixedInterval(1, ResampleDrop)
+	if l := result.Length(); l != 8 {
+		t.Errorf("path, resample fixed incorrect length, got %d", l)
+	}
+
+	if v := result.GetAt(4); !v.Equals(NewPoint(0, 4)) {
+		t.Errorf("path, resample fixed incorrect point, got %v", v)
+	}
+
+	if v := result.GetAt(7); !v.Equals(NewPoint(3, 4)) {
+		t.Errorf("path, resample fixed incorrect point, got %v", v)
+	}
+
+	if !result.Equals(p.Clone().ResampleWithFixedInterval(1, ResampleKeepEnd)) {
+		t.Errorf("path, resample fixed should not duplicate end point")
+	}
+
+	// partial final interval
+	result = p.Clone().ResampleWithFixedInterval(3, ResampleDrop)
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0)).Push(NewPoint(0, 3)).Push(NewPoint(2, 4))
+	if !result.Equals(expected) {
+		t.Errorf("path, resample fixed drop incorrect, got %v", result)
+	}
+
+	result = p.Clone().ResampleWithFixedInterval(3, ResampleKeepEnd)
+	expected.Push(NewPoint(3, 4))
+	if !result.Equals(expected) {
+		t.Errorf("path, resample fixed keep end incorrect, got %v", result)
+	}
+
+	result = p.Clone().ResampleWithFixedInterval(3, ResampleStretch)
+	if !result.Equals(p.Clone().ResampleWithInterval(3)) {
+		t.Errorf("path, resample fixed stretch should be the same as with interval, got %v", result)
+	}
+
+	// interval longer than the path
+	result = p.Clone().ResampleWithFixedInterval(10, ResampleDrop)
+	expected = NewPath().Push(NewPoint(0, 0))
+	if !result.Equals(expected) {
+		t.Errorf("path, resample fixed long drop incorrect, got %v", result)
+	}
+
+	result = p.Clone().ResampleWithFixedInterval(10, ResampleKeepEnd)
+	expected.Push(NewPoint(3, 4))
+	if !result.Equals(expected) {
+		t.Errorf("path, resample fixed long keep end incorrect, got %v", result)
+	}
+
+	result = p.Clone().ResampleWithFixedInterval(10, ResampleStretch)
+	if l := result.Length(); l != 1 {
+		t.Errorf("path, resample fixed long stretch incorrect, got %v", result)
+	}
+}
+
+func TestPathResampleWithFixedGeoInterval(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 10))
+
+	d := p.GeoDistance() / 4
+	result := p.Clone().ResampleWithFixedGeoInterval(1.5*d, ResampleDrop)
+	if l := result.Length(); l != 3 {
+		t.Errorf("path, resample fixed geo incorrect length, got %d", l)
+	}
+
+	if v := result.GetAt(1); math.Abs(v.Lat()-3.75) > epsilon {
+		t.Errorf("path, resample fixed geo incorrect point, got %v", v)
+	}
+
+	result = p.Clone().ResampleWithFixedGeoInterval(1.5*d, ResampleKeepEnd)
+	if l := result.Length(); l != 4 {
+		t.Errorf("path, resample fixed geo incorrect length, got %d", l)
+	}
+
+	if v := result.GetAt(3); !v.Equals(NewPoint(0, 10)) {
+		t.Errorf("path, resample fixed geo should keep end, got %v", v)
+	}
+}
+
 func TestPathResampleEdgeCases(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0, 0))
@@ -0,0 +1,236 @@
+package geo
+
+import "math"
+
+// HausdorffDistance computes the discrete Hausdorff distance between the vertices
+// of the two paths, ie. the farthest any vertex of one path is from the nearest
+// vertex of the other. Returns 0 if both paths are empty and Inf if only one is.
+// Assumes euclidean geometry.
+func (p *Path) HausdorffDistance(path *Path) float64 {
+	return math.Sqrt(hausdorff(p.PointSet, path.PointSet, func(a, b *Point) float64 {
+		return a.SquaredDistanceFrom(b)
+	}))
+}
+
+// GeoHausdorffDistance computes the discrete Hausdorff distance between the vertices
+// of the two paths, in meters, using GeoDistanceFrom.
+// Returns 0 if both paths are empty and Inf if only one is.
+func (p *Path) GeoHausdorffDistance(path *Path, haversine ...bool) float64 {
+	yesgeo := yesHaversine(haversine)
+	return hausdorff(p.PointSet, path.PointSet, func(a, b *Point) float64 {
+		return a.GeoDistanceFrom(b, yesgeo)
+	})
+}
+
+func hausdorff(ps1, ps2 PointSet, distance func(a, b *Point) float64) float64 {
+	if len(ps1) == 0 && len(ps2) == 0 {
+		return 0
+	}
+
+	if len(ps1) == 0 || len(ps2) == 0 {
+		return math.Inf(1)
+	}
+
+	return math.Max(directedHausdorff(ps1, ps2, distance), directedHausdorff(ps2, ps1, distance))
+}
+
+func directedHausdorff(ps1, ps2 PointSet, distance func(a, b *Point) float64) float64 {
+	max := 0.0
+	for i := range ps1 {
+		min := math.Inf(1)
+		for j := range ps2 {
+			d := distance(&ps1[i], &ps2[j])
+			if d < min {
+				min = d
+			}
+
+			// can not increase the max
+			if min <= max {
+				break
+			}
+		}
+
+		if min > max {
+			max = min
+		}
+	}
+
+	return max
+}
+
+// FrechetDistance computes the discrete Fréchet distance between the two paths,
+// the shortest "leash" needed to walk the vertices of both paths, in order, at the
+// same time. This is O(n*m) using dynamic programming.
+// Returns 0 if both paths are empty and Inf if only one is. Assumes euclidean geometry.
+func (p *Path) FrechetDistance(path *Path) float64 {
+	return math.Sqrt(frechet(p.PointSet, path.PointSet, func(a, b *Point) float64 {
+		return a.SquaredDistanceFrom(b)
+	}))
+}
+
+// GeoFrechetDistance computes the discrete Fréchet distance between the two paths,
+// in meters, using GeoDistanceFrom. This is O(n*m) using dynamic programming.
+// Returns 0 if both paths are empty and Inf if only one is.
+func (p *Path) GeoFrechetDistance(path *Path, haversine ...bool) float64 {
+	yesgeo := yesHaversine(haversine)
+	return frechet(p.PointSet, path.PointSet, func(a, b *Point) float64 {
+		return a.GeoDistanceFrom(b, yesgeo)
+	})
+}
+
+func frechet(ps1, ps2 PointSet, distance func(a, b *Point) float64) float64 {
+	if len(ps1) == 0 && len(ps2) == 0 {
+		return 0
+	}
+
+	if len(ps1) == 0 || len(ps2) == 0 {
+		return math.Inf(1)
+	}
+
+	// only the previous row of the table is needed
+	prev := make([]float64, len(ps2))
+	current := make([]float64, len(ps2))
+
+	for i := range ps1 {
+		for j := range ps2 {
+			d := distance(&ps1[i], &ps2[j])
+
+			switch {
+			case i == 0 && j == 0:
+				current[j] = d
+			case i == 0:
+				current[j] = math.Max(current[j-1], d)
+			case j == 0:
+				current[j] = math.Max(prev[j], d)
+			default:
+				current[j] = math.Max(math.Min(math.Min(prev[j], prev[j-1]), current[j-1]), d)
+			}
+		}
+
+		prev, current = current, prev
+	}
+
+	return prev[len(ps2)-1]
+}
+
+// DTW computes the dynamic time warping alignment between the vertices of the two paths.
+// Returns the total cost, the sum of the distances between the aligned vertices,
+// and the warping path as a monotonic slice of index pairs [i, j] from [0, 0] to
+// [p.Length()-1, path.Length()-1]. Memory is O(n*m), see DTWWithBand for long paths.
+// Assumes euclidean geometry.
+func (p *Path) DTW(path *Path) (float64, [][2]int) {
+	return p.DTWWithBand(path, -1)
+}
+
+// DTWWithBand computes the dynamic time warping alignment between the vertices of
+// the two paths, as DTW, within a Sakoe-Chiba band. The band, the maximum number of
+// indexes the alignment can deviate from the diagonal, bounds the memory needed
+// to O(n*band). The band is widened to at least the difference in lengths.
+// A negative band means no band. Assumes euclidean geometry.
+func (p *Path) DTWWithBand(path *Path, band int) (float64, [][2]int) {
+	return dtw(p.PointSet, path.PointSet, band, func(a, b *Point) float64 {
+		return a.DistanceFrom(b)
+	})
+}
+
+// GeoDTW computes the dynamic time warping alignment between the vertices of the two
+// paths, as DTW, using GeoDistanceFrom to get a cost in meters.
+func (p *Path) GeoDTW(path *Path, haversine ...bool) (float64, [][2]int) {
+	return p.GeoDTWWithBand(path, -1, haversine...)
+}
+
+// GeoDTWWithBand computes the dynamic time warping alignment between the vertices
+// of the two paths, as GeoDTW, within a Sakoe-Chiba band as DTWWithBand.
+// A negative band means no band.
+func (p *Path) GeoDTWWithBand(path *Path, band int, haversine ...bool) (float64, [][2]int) {
+	yesgeo := yesHaversine(haversine)
+	return dtw(p.PointSet, path.PointSet, band, func(a, b *Point) float64 {
+		return a.GeoDistanceFrom(b, yesgeo)
+	})
+}
+
+func dtw(ps1, ps2 PointSet, band int, distance func(a, b *Point) float64) (float64, [][2]int) {
+	n, m := len(ps1), len(ps2)
+	if n == 0 && m == 0 {
+		return 0, nil
+	}
+
+	if n == 0 || m == 0 {
+		return math.Inf(1), nil
+	}
+
+	width := m
+	if band >= 0 {
+		width = band
+		if d := n - m; d > width {
+			width = d
+		} else if -d > width {
+			width = -d
+		}
+	}
+
+	// only the cells within the band around the diagonal are stored,
+	// row i has the columns starting at starts[i].
+	starts := make([]int, n)
+	costs := make([][]float64, n)
+	cost := func(i, j int) float64 {
+		if i < 0 || j < starts[i] || j-starts[i] >= len(costs[i]) {
+			return math.Inf(1)
+		}
+
+		return costs[i][j-starts[i]]
+	}
+
+	for i := 0; i < n; i++ {
+		center := 0
+		if n > 1 {
+			center = int(math.Floor(float64(i*(m-1))/float64(n-1) + 0.5))
+		}
+
+		lo, hi := center-width, center+width
+		if lo < 0 {
+			lo = 0
+		}
+
+		if hi > m-1 {
+			hi = m - 1
+		}
+
+		starts[i] = lo
+		costs[i] = make([]float64, hi-lo+1)
+
+		for j := lo; j <= hi; j++ {
+			best := 0.0
+			if i > 0 || j > 0 {
+				best = math.Min(math.Min(cost(i-1, j-1), cost(i-1, j)), cost(i, j-1))
+			}
+
+			costs[i][j-lo] = distance(&ps1[i], &ps2[j]) + best
+		}
+	}
+
+	// backtrack from the end, preferring the diagonal
+	i, j := n-1, m-1
+	alignment := [][2]int{{i, j}}
+	for i > 0 || j > 0 {
+		ni, nj := i-1, j-1
+		best := cost(ni, nj)
+
+		if c := cost(i-1, j); c < best {
+			ni, nj, best = i-1, j, c
+		}
+
+		if c := cost(i, j-1); c < best {
+			ni, nj = i, j-1
+		}
+
+		i, j = ni, nj
+		alignment = append(alignment, [2]int{i, j})
+	}
+
+	for a, b := 0, len(alignment)-1; a < b; a, b = a+1, b-1 {
+		alignment[a], alignment[b] = alignment[b], alignment[a]
+	}
+
+	return cost(n-1, m-1), alignment
+}
@@ -0,0 +1,248 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPathHausdorffDistance(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0))
+
+	if d := p1.HausdorffDistance(p1.Clone()); d != 0 {
+		t.Errorf("path, hausdorff of identical paths should be 0, got %f", d)
+	}
+
+	offset := p1.Clone()
+	for i := range offset.PointSet {
+		offset.PointSet[i][1] += 0.5
+	}
+
+	if d := p1.HausdorffDistance(offset); d != 0.5 {
+		t.Errorf("path, hausdorff of offset path expected 0.5, got %f", d)
+	}
+
+	// different lengths, is symmetric
+	p2 := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(2, 0)).Push(NewPoint(5, 0))
+	if d := p1.HausdorffDistance(p2); d != 3 {
+		t.Errorf("path, hausdorff expected 3, got %f", d)
+	}
+
+	if d := p2.HausdorffDistance(p1); d != 3 {
+		t.Errorf("path, hausdorff should be symmetric, got %f", d)
+	}
+
+	// single point
+	p2 = NewPath().Push(NewPoint(1, 1))
+	if d := p1.HausdorffDistance(p2); d != math.Sqrt(2) {
+		t.Errorf("path, hausdorff with single point expected %f, got %f", math.Sqrt(2), d)
+	}
+
+	if d := NewPath().HausdorffDistance(NewPath()); d != 0 {
+		t.Errorf("path, hausdorff of empty paths should be 0, got %f", d)
+	}
+
+	if d := p1.HausdorffDistance(NewPath()); !math.IsInf(d, 1) {
+		t.Errorf("path, hausdorff with empty path should be inf, got %f", d)
+	}
+}
+
+func TestPathGeoHausdorffDistance(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(-122.4, 37.7)).
+		Push(NewPoint(-122.3, 37.8))
+
+	p2 := NewPath().
+		Push(NewPoint(-122.4, 37.7)).
+		Push(NewPoint(-122.3, 37.81))
+
+	expected := NewPoint(-122.3, 37.8).GeoDistanceFrom(NewPoint(-122.3, 37.81), true)
+	if d := p1.GeoHausdorffDistance(p2, true); math.Abs(d-expected) > epsilon {
+		t.Errorf("path, geo hausdorff expected %f, got %f", expected, d)
+	}
+}
+
+func TestPathFrechetDistance(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0))
+
+	if d := p1.FrechetDistance(p1.Clone()); d != 0 {
+		t.Errorf("path, frechet of identical paths should be 0, got %f", d)
+	}
+
+	offset := p1.Clone()
+	for i := range offset.PointSet {
+		offset.PointSet[i][1] += 0.5
+	}
+
+	if d := p1.FrechetDistance(offset); d != 0.5 {
+		t.Errorf("path, frechet of offset path expected 0.5, got %f", d)
+	}
+
+	// same points in the opposite direction, hausdorff can not tell the difference
+	reversed := p1.Clone().Reverse()
+	if d := p1.HausdorffDistance(reversed); d != 0 {
+		t.Errorf("path, hausdorff of reversed path expected 0, got %f", d)
+	}
+
+	if d := p1.FrechetDistance(reversed); d != 2 {
+		t.Errorf("path, frechet of reversed path expected 2, got %f", d)
+	}
+
+	// different lengths
+	p2 := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(2, 0))
+	if d := p1.FrechetDistance(p2); d != 1 {
+		t.Errorf("path, frechet expected 1, got %f", d)
+	}
+
+	if d := p2.FrechetDistance(p1); d != 1 {
+		t.Errorf("path, frechet should be symmetric, got %f", d)
+	}
+
+	// single point
+	p2 = NewPath().Push(NewPoint(1, 0))
+	if d := p1.FrechetDistance(p2); d != 1 {
+		t.Errorf("path, frechet with single point expected 1, got %f", d)
+	}
+
+	if d := NewPath().FrechetDistance(NewPath()); d != 0 {
+		t.Errorf("path, frechet of empty paths should be 0, got %f", d)
+	}
+
+	if d := p1.FrechetDistance(NewPath()); !math.IsInf(d, 1) {
+		t.Errorf("path, frechet with empty path should be inf, got %f", d)
+	}
+}
+
+func TestPathGeoFrechetDistance(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(-122.4, 37.7)).
+		Push(NewPoint(-122.3, 37.8))
+
+	p2 := p1.Clone().Reverse()
+
+	expected := p1.GeoDistance(true)
+	if d := p1.GeoFrechetDistance(p2, true); math.Abs(d-expected) > epsilon {
+		t.Errorf("path, geo frechet expected %f, got %f", expected, d)
+	}
+}
+
+func TestPathDTW(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 50; i++ {
+		x := float64(i) / 5
+		p.Push(NewPoint(x, math.Sin(x)))
+	}
+
+	cost, alignment := p.DTW(p.Clone())
+	if cost != 0 {
+		t.Errorf("path, dtw of identical paths should be 0, got %f", cost)
+	}
+
+	for i, a := range alignment {
+		if a != [2]int{i, i} {
+			t.Errorf("path, dtw of identical paths should align the diagonal, got %v", a)
+			break
+		}
+	}
+
+	resampled := p.Clone().Densify(0.05).Resample(120)
+	cost, alignment = p.DTW(resampled)
+	checkDTWAlignment(t, alignment, p.Length(), resampled.Length())
+
+	// less than half the spacing of the original points
+	if avg := cost / float64(len(alignment)); avg > 0.1 {
+		t.Errorf("path, dtw with resampled copy should be near zero, got %f per pair", avg)
+	}
+
+	// band
+	bandCost, bandAlignment := p.DTWWithBand(resampled, 100)
+	if bandCost != cost {
+		t.Errorf("path, dtw with wide band should match, %f != %f", bandCost, cost)
+	}
+	checkDTWAlignment(t, bandAlignment, p.Length(), resampled.Length())
+
+	bandCost, bandAlignment = p.DTWWithBand(resampled, 0)
+	if bandCost < cost {
+		t.Errorf("path, dtw with narrow band can not be less, %f < %f", bandCost, cost)
+	}
+	checkDTWAlignment(t, bandAlignment, p.Length(), resampled.Length())
+
+	if bandCost, _ := p.DTWWithBand(resampled, -1); bandCost != cost {
+		t.Errorf("path, dtw with negative band should be no band, %f != %f", bandCost, cost)
+	}
+
+	// single points
+	cost, alignment = NewPath().Push(NewPoint(0, 0)).DTW(NewPath().Push(NewPoint(3, 4)).Push(NewPoint(0, 0)))
+	if cost != 5 {
+		t.Errorf("path, dtw with single point expected 5, got %f", cost)
+	}
+	checkDTWAlignment(t, alignment, 1, 2)
+
+	if cost, alignment := p.DTW(NewPath()); !math.IsInf(cost, 1) || alignment != nil {
+		t.Errorf("path, dtw with empty path should be inf, got %f %v", cost, alignment)
+	}
+}
+
+func TestPathGeoDTW(t *testing.T) {
+	p1 := NewPath().Push(NewPoint(-122.4, 37.7)).Push(NewPoint(-122.3, 37.8))
+	p2 := NewPath().Push(NewPoint(-122.4, 37.7)).Push(NewPoint(-122.35, 37.75)).Push(NewPoint(-122.3, 37.8))
+
+	// the middle point is aligned with the closest end
+	expected := math.Min(
+		NewPoint(-122.35, 37.75).GeoDistanceFrom(NewPoint(-122.4, 37.7)),
+		NewPoint(-122.35, 37.75).GeoDistanceFrom(NewPoint(-122.3, 37.8)),
+	)
+	cost, alignment := p1.GeoDTW(p2)
+	if math.Abs(cost-expected) > epsilon {
+		t.Errorf("path, geo dtw expected %f, got %f", expected, cost)
+	}
+	checkDTWAlignment(t, alignment, 2, 3)
+
+	// haversine
+	expected = math.Min(
+		NewPoint(-122.35, 37.75).GeoDistanceFrom(NewPoint(-122.4, 37.7), true),
+		NewPoint(-122.35, 37.75).GeoDistanceFrom(NewPoint(-122.3, 37.8), true),
+	)
+	cost, alignment = p1.GeoDTW(p2, true)
+	if math.Abs(cost-expected) > epsilon {
+		t.Errorf("path, geo dtw haversine expected %f, got %f", expected, cost)
+	}
+	checkDTWAlignment(t, alignment, 2, 3)
+
+	if c, _ := p1.GeoDTW(p2, false); c == cost {
+		t.Errorf("path, geo dtw haversine should differ from the approximation, got %f", c)
+	}
+
+	// the band is widened to the difference in lengths
+	if c, a := p1.GeoDTWWithBand(p2, 0, true); math.Abs(c-cost) > epsilon {
+		t.Errorf("path, geo dtw with band expected %f, got %f", cost, c)
+	} else {
+		checkDTWAlignment(t, a, 2, 3)
+	}
+}
+
+func checkDTWAlignment(t *testing.T, alignment [][2]int, n, m int) {
+	if len(alignment) == 0 {
+		t.Errorf("path, dtw alignment should not be empty")
+		return
+	}
+
+	if alignment[0] != [2]int{0, 0} || alignment[len(alignment)-1] != [2]int{n - 1, m - 1} {
+		t.Errorf("path, dtw alignment should go from start to end, got %v to %v", alignment[0], alignment[len(alignment)-1])
+	}
+
+	for i := 1; i < len(alignment); i++ {
+		di := alignment[i][0] - alignment[i-1][0]
+		dj := alignment[i][1] - alignment[i-1][1]
+
+		if di < 0 || dj < 0 || di > 1 || dj > 1 || di+dj == 0 {
+			t.Errorf("path, dtw alignment should be monotonic, got %v then %v", alignment[i-1], alignment[i])
+			return
+		}
+	}
+}
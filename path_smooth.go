@@ -0,0 +1,97 @@
+package geo
+
+// Smooth runs the given number of iterations of Chaikin's corner cutting algorithm.
+// Each segment is replaced by the points 1/4 and 3/4 of the way along it,
+// while the first and last points are kept. Every iteration grows
+// a path of n points to 2n points and, since corners are cut, the path can only get shorter.
+// Closed paths, where the first point equals the last, are smoothed cyclically
+// so the corner at the start is also cut, growing n points to 2n-1.
+// Assumes euclidean geometry.
+func (p *Path) Smooth(iterations int) *Path {
+	if len(p.PointSet) < 3 {
+		return p
+	}
+
+	closed := p.IsRing()
+	for i := 0; i < iterations; i++ {
+		p.PointSet = chaikin(p.PointSet, closed)
+	}
+
+	return p
+}
+
+func chaikin(ps PointSet, closed bool) PointSet {
+	points := make([]Point, 0, 2*len(ps))
+	if !closed {
+		points = append(points, ps[0])
+	}
+
+	for i := 0; i < len(ps)-1; i++ {
+		a := ps[i]
+		b := ps[i+1]
+
+		points = append(points,
+			Point{0.75*a[0] + 0.25*b[0], 0.75*a[1] + 0.25*b[1]},
+			Point{0.25*a[0] + 0.75*b[0], 0.25*a[1] + 0.75*b[1]},
+		)
+	}
+
+	if closed {
+		return append(points, points[0])
+	}
+
+	return append(points, ps[len(ps)-1])
+}
+
+// SmoothMovingAverage replaces each point with the average of itself and up to
+// window points on either side. Near the ends of the path the window shrinks
+// so it stays centered, so the endpoints are kept and evenly spaced straight lines
+// are unchanged. Closed paths, where the first point equals the last, are averaged
+// cyclically. Assumes euclidean geometry.
+func (p *Path) SmoothMovingAverage(window int) *Path {
+	if window < 1 || len(p.PointSet) < 3 {
+		return p
+	}
+
+	closed := p.IsRing()
+
+	// the number of unique points, the last point of a closed path is a repeat
+	n := len(p.PointSet)
+	if closed {
+		n--
+	}
+
+	points := make([]Point, len(p.PointSet))
+	for i := 0; i < n; i++ {
+		k := window
+		if !closed {
+			k = minInt(k, minInt(i, n-1-i))
+		} else if 2*k+1 > n {
+			k = (n - 1) / 2
+		}
+
+		var x, y float64
+		for j := i - k; j <= i+k; j++ {
+			point := p.PointSet[(j+n)%n]
+			x += point[0]
+			y += point[1]
+		}
+
+		points[i] = Point{x / float64(2*k+1), y / float64(2*k+1)}
+	}
+
+	if closed {
+		points[n] = points[0]
+	}
+
+	p.PointSet = points
+	return p
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,174 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPathSmooth(t *testing.T) {
+	p := NewPath()
+	p.Smooth(2) // should not panic
+
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 1))
+	if l := p.Smooth(2).Length(); l != 2 {
+		t.Errorf("path, smooth should not change two point paths, got %d", l)
+	}
+
+	p.Push(NewPoint(2, 0))
+	p.Smooth(1)
+
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(0.25, 0.25))
+	expected.Push(NewPoint(0.75, 0.75))
+	expected.Push(NewPoint(1.25, 0.75))
+	expected.Push(NewPoint(1.75, 0.25))
+	expected.Push(NewPoint(2, 0))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, smooth incorrect, got %v", p)
+	}
+
+	if l := p.Smooth(0).Length(); l != 6 {
+		t.Errorf("path, smooth with zero iterations should do nothing, got %d", l)
+	}
+}
+
+func TestPathSmoothDistance(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(3, 1))
+	p.Push(NewPoint(4, 0))
+
+	length := p.Length()
+	distance := p.Distance()
+	for i := 0; i < 8; i++ {
+		p.Smooth(1)
+
+		if l := p.Length(); l != 2*length {
+			t.Errorf("path, smooth should double the number of points, expected %d, got %d", 2*length, l)
+		}
+
+		if d := p.Distance(); d > distance {
+			t.Errorf("path, smooth should not increase the distance, %f > %f", d, distance)
+		}
+
+		length = p.Length()
+		distance = p.Distance()
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(0, 0)) || !p.GetAt(p.Length()-1).Equals(NewPoint(4, 0)) {
+		t.Errorf("path, smooth should keep the end points")
+	}
+}
+
+func TestPathSmoothClosed(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(4, 0))
+	p.Push(NewPoint(4, 4))
+	p.Push(NewPoint(0, 4))
+	p.Push(NewPoint(0, 0))
+
+	p.Smooth(1)
+	if l := p.Length(); l != 9 {
+		t.Errorf("path, smooth of closed path expected 9 points, got %d", l)
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(1, 0)) {
+		t.Errorf("path, smooth of closed path should cut the first corner, got %v", p.GetAt(0))
+	}
+
+	if !p.GetAt(0).Equals(p.GetAt(p.Length() - 1)) {
+		t.Errorf("path, smooth of closed path should stay closed")
+	}
+}
+
+func TestPathSmoothStraightLine(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(NewPoint(float64(i), 2*float64(i)))
+	}
+
+	distance := p.Distance()
+	p.Smooth(3)
+
+	if d := p.Distance(); math.Abs(d-distance) > epsilon {
+		t.Errorf("path, smooth of straight line should not change distance, %f != %f", d, distance)
+	}
+
+	for _, point := range p.PointSet {
+		if math.Abs(point[1]-2*point[0]) > epsilon {
+			t.Errorf("path, smooth of straight line should stay on the line, got %v", point)
+		}
+	}
+}
+
+func TestPathSmoothMovingAverage(t *testing.T) {
+	p := NewPath()
+	p.SmoothMovingAverage(2) // should not panic
+
+	// straight, evenly spaced, line is unchanged
+	for i := 0; i < 6; i++ {
+		p.Push(NewPoint(float64(i), 2*float64(i)))
+	}
+
+	expected := p.Clone()
+	if !p.SmoothMovingAverage(2).Equals(expected) {
+		t.Errorf("path, moving average of straight line should not change, got %v", p)
+	}
+
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 3))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(3, 3))
+	p.Push(NewPoint(4, 0))
+	p.SmoothMovingAverage(1)
+
+	expected = NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(1, 1))
+	expected.Push(NewPoint(2, 2))
+	expected.Push(NewPoint(3, 1))
+	expected.Push(NewPoint(4, 0))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, moving average expected %v, got %v", expected, p)
+	}
+
+	if !p.Clone().SmoothMovingAverage(0).Equals(p) {
+		t.Errorf("path, moving average with zero window should do nothing")
+	}
+}
+
+func TestPathSmoothMovingAverageClosed(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(3, 0))
+	p.Push(NewPoint(3, 3))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(0, 0))
+
+	p.SmoothMovingAverage(1)
+
+	expected := NewPath()
+	expected.Push(NewPoint(1, 1))
+	expected.Push(NewPoint(2, 1))
+	expected.Push(NewPoint(2, 2))
+	expected.Push(NewPoint(1, 2))
+	expected.Push(NewPoint(1, 1))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, moving average of closed path expected %v, got %v", expected, p)
+	}
+
+	// window larger than the path
+	p.SmoothMovingAverage(10)
+	if p.Length() != 5 || !p.GetAt(0).Equals(p.GetAt(4)) {
+		t.Errorf("path, moving average with large window should stay closed, got %v", p)
+	}
+}
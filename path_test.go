@@ -2,9 +2,14 @@ package geo
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/paulmach/go.geojson"
 )
 
 func TestNewPathPreallocate(t *testing.T) {
@@ -52,6 +57,159 @@ func TestNewPathFromEncoding(t *testing.T) {
 	}
 }
 
+func TestNewPathFromEncodingGoogleExample(t *testing.T) {
+	// example from https://developers.google.com/maps/documentation/utilities/polylinealgorithm
+	expected := NewPath()
+	expected.Push(NewPointFromLatLng(38.5, -120.2))
+	expected.Push(NewPointFromLatLng(40.7, -120.95))
+	expected.Push(NewPointFromLatLng(43.252, -126.453))
+
+	path := NewPathFromEncoding("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+	if !path.Equals(expected) {
+		t.Errorf("path, decode google example incorrect, got %v", path)
+	}
+
+	if encoded := expected.Encode(); encoded != "_p~iF~ps|U_ulLnnqC_mqNvxq`@" {
+		t.Errorf("path, encode google example incorrect, got %s", encoded)
+	}
+
+	// 6 digits of precision as used by OSRM
+	encoded := expected.Encode(1e6)
+	if encoded != "_izlhA~rlgdF_{geC~ywl@_kwzCn`{nI" {
+		t.Errorf("path, encode with 6 digits incorrect, got %s", encoded)
+	}
+
+	if path := NewPathFromEncoding(encoded, 1e6); !path.Equals(expected) {
+		t.Errorf("path, decode with 6 digits incorrect, got %v", path)
+	}
+}
+
+func TestDecodePolyline(t *testing.T) {
+	p, err := DecodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+	if err != nil {
+		t.Fatalf("path, decode polyline error: %v", err)
+	}
+
+	if expected := NewPathFromEncoding("_p~iF~ps|U_ulLnnqC_mqNvxq`@"); !p.Equals(expected) {
+		t.Errorf("path, decode polyline incorrect, got %v", p)
+	}
+
+	p, err = DecodePolyline("_izlhA~rlgdF_{geC~ywl@_kwzCn`{nI", 1e6)
+	if err != nil {
+		t.Fatalf("path, decode polyline error: %v", err)
+	}
+
+	if v := p.GetAt(2); v.Lat() != 43.252 || v.Lng() != -126.453 {
+		t.Errorf("path, decode polyline 6 digits incorrect, got %v", v)
+	}
+
+	// round trip to 5 decimal places
+	original := NewPath()
+	for i := 0; i < 100; i++ {
+		original.Push(NewPoint(360*rand.Float64()-180, 180*rand.Float64()-90))
+	}
+
+	p, err = DecodePolyline(original.Encode())
+	if err != nil {
+		t.Fatalf("path, decode polyline error: %v", err)
+	}
+
+	for i := range original.PointSet {
+		if d := math.Abs(p.PointSet[i][0] - original.PointSet[i][0]); d > 0.5e-5+epsilon {
+			t.Errorf("path, decode polyline lng error too big: %f", d)
+		}
+
+		if d := math.Abs(p.PointSet[i][1] - original.PointSet[i][1]); d > 0.5e-5+epsilon {
+			t.Errorf("path, decode polyline lat error too big: %f", d)
+		}
+	}
+
+	// errors
+	errorTests := []string{
+		"_p~iF~ps|U_ulLnnqC_mqNvxq",    // truncated
+		"_p~iF~ps|U_ulLnnqC_mqN",       // lat without lng
+		"_p~iF~ps|U_ulL nnqC_mqNvxq`@", // invalid character
+		"_p~iF~ps|U_ulLnnqC_mqNvxq`@\x80",
+		"_uybQ~ps|U", // lat out of range, 95 degrees
+	}
+
+	// malformed trailing bytes
+	valid := "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+	for _, trailing := range []string{"?", "_", "~", "?_"} {
+		errorTests = append(errorTests, valid+trailing)
+	}
+
+	for _, test := range errorTests {
+		if p, err := DecodePolyline(test); err == nil {
+			t.Errorf("path, decode polyline should error for %s, got %v", test, p)
+		}
+
+		// should not panic
+		NewPathFromEncoding(test)
+	}
+
+	// a precision instead of a factor
+	for _, factor := range []int{-1, 0, 5, 6} {
+		if p, err := DecodePolyline(valid, factor); err == nil || !strings.Contains(err.Error(), "factor") {
+			t.Errorf("path, decode polyline should error for factor %d, got %v %v", factor, p, err)
+		}
+	}
+}
+
+func TestPathEncodeDecodeRoundTrip(t *testing.T) {
+	tests := []struct {
+		encoded string
+		factors []int
+	}{
+		{"_p~iF~ps|U_ulLnnqC_mqNvxq`@", []int{1e5, 1e6}},
+		{"smsqgAtkxvhFwf@{zCeZeYdh@{t@}BiAmu@sSqg@cjE", []int{1e6}},
+		{"", []int{1e5, 1e6}},
+	}
+
+	for _, test := range tests {
+		for _, factor := range test.factors {
+			p, err := DecodePolyline(test.encoded, factor)
+			if err != nil {
+				t.Errorf("path, decode %s with factor %d should not error: %v", test.encoded, factor, err)
+				continue
+			}
+
+			if e := p.Encode(factor); e != test.encoded {
+				t.Errorf("path, encode decode round trip failed, %s != %s", e, test.encoded)
+			}
+		}
+	}
+
+	// 6 digit data decoded at 5 digits is out of range
+	if p, err := DecodePolyline(tests[1].encoded, 1e5); err == nil {
+		t.Errorf("path, 6 digit data with factor 1e5 should error, got %v", p)
+	}
+}
+
+func TestPathEncodeDecodeApproxRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	p := NewPath()
+	for i := 0; i < 100; i++ {
+		p.Push(NewPoint(360*r.Float64()-180, 180*r.Float64()-90))
+	}
+
+	for _, factor := range []int{1e5, 1e6} {
+		decoded, err := DecodePolyline(p.Encode(factor), factor)
+		if err != nil {
+			t.Fatalf("path, decode should not error: %v", err)
+		}
+
+		if decoded.Equals(p) {
+			t.Errorf("path, random data should lose precision when encoded")
+		}
+
+		if !decoded.ApproxEquals(p, 1.0/float64(factor)) {
+			t.Errorf("path, encode decode round trip should be within precision for factor %d", factor)
+		}
+	}
+}
+
 func TestNewPathFromXYData(t *testing.T) {
 	data := [][2]float64{
 		{1, 2},
@@ -113,6 +271,35 @@ func TestNewPathFromXYSlice(t *testing.T) {
 	}
 }
 
+func TestNewPathFromFlatCoords(t *testing.T) {
+	p, err := NewPathFromFlatCoords([]float64{1, 2, 3, 4, 5, 6})
+	if err != nil {
+		t.Fatalf("path, from flat coords error: %v", err)
+	}
+
+	expected := NewPath().Push(NewPoint(1, 2)).Push(NewPoint(3, 4)).Push(NewPoint(5, 6))
+	if !p.Equals(expected) {
+		t.Errorf("path, from flat coords incorrect, got %v", p)
+	}
+
+	if c := p.FlatCoords(); !reflect.DeepEqual(c, []float64{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("path, flat coords incorrect, got %v", c)
+	}
+
+	p, err = NewPathFromFlatCoords(nil)
+	if err != nil || p.Length() != 0 {
+		t.Errorf("path, from empty flat coords should be empty path, got %v %v", p, err)
+	}
+
+	if c := p.FlatCoords(); len(c) != 0 {
+		t.Errorf("path, flat coords of empty path should be empty, got %v", c)
+	}
+
+	if _, err := NewPathFromFlatCoords([]float64{1, 2, 3}); err == nil {
+		t.Error("path, from flat coords with odd length should error")
+	}
+}
+
 func TestNewPathFromYXSlice(t *testing.T) {
 	data := [][]float64{
 		{1, 2},
@@ -164,156 +351,1341 @@ func TestPathPoints(t *testing.T) {
 	}
 }
 
-func TestPathEncode(t *testing.T) {
-	for loop := 0; loop < 100; loop++ {
-		p := NewPath()
-		for i := 0; i < 100; i++ {
-			p.Push(&Point{rand.Float64(), rand.Float64()})
+func TestPathTranslate(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 2))
+	original := p.Clone()
+
+	expected := NewPath().Push(NewPoint(3, -1)).Push(NewPoint(4, 1))
+	translated := p.Translate(NewPoint(3, -1))
+	if !translated.Equals(expected) {
+		t.Errorf("path, translate expected %v, got %v", expected, translated)
+	}
+
+	if !p.Equals(original) {
+		t.Errorf("path, translate should not modify the original, got %v", p)
+	}
+
+	if d1, d2 := original.Distance(), translated.Distance(); d1 != d2 {
+		t.Errorf("path, translate should not change distance, %f != %f", d1, d2)
+	}
+}
+
+func TestPathScale(t *testing.T) {
+	p := NewPath().Push(NewPoint(1, 1)).Push(NewPoint(3, 1)).Push(NewPoint(3, 3))
+	original := p.Clone()
+
+	expected := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(4, 0)).Push(NewPoint(4, 4))
+	if s := p.Scale(2, NewPoint(2, 2)); !s.Equals(expected) {
+		t.Errorf("path, scale expected %v, got %v", expected, s)
+	}
+
+	if !p.Equals(original) {
+		t.Errorf("path, scale should not modify the original, got %v", p)
+	}
+
+	expected = NewPath().Push(NewPoint(2, 2)).Push(NewPoint(6, 2)).Push(NewPoint(6, 6))
+	if s := p.Scale(2, NewPoint(0, 0)); !s.Equals(expected) {
+		t.Errorf("path, scale about origin expected %v, got %v", expected, s)
+	}
+
+	if s := p.Scale(1, NewPoint(-7, 12)); !s.Equals(p) {
+		t.Errorf("path, scale by 1 should not change the path, got %v", s)
+	}
+
+	// not exactly representable, (x-c)*1+c would round
+	p = NewPath().Push(NewPoint(0.1, 0.7)).Push(NewPoint(-122.4194, 37.7749)).Push(NewPoint(1.0/3, 2.0/7))
+	if s := p.Scale(1, NewPoint(0.3, -12.345)); !s.Equals(p) {
+		t.Errorf("path, scale by 1 should be identical, got %v", s)
+	}
+}
+
+func TestPathScaleAboutCentroid(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(4, 0)).
+		Push(NewPoint(4, 1)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(1, 4)).
+		Push(NewPoint(0, 4))
+
+	centroid := p.Centroid()
+	area := p.Area()
+
+	for _, factor := range []float64{0.5, 2, 3} {
+		s := p.Scale(factor, centroid)
+
+		if c := s.Centroid(); c.DistanceFrom(centroid) > epsilon {
+			t.Errorf("path, scale about centroid should keep it centered, %v != %v", c, centroid)
 		}
 
-		encoded := p.Encode()
-		for _, c := range encoded {
-			if c < 63 || c > 127 {
-				t.Errorf("path, encode result out of range: %d", c)
+		if a := s.Area(); math.Abs(a-factor*factor*area) > epsilon {
+			t.Errorf("path, scale by %f should scale the area to %f, got %f", factor, factor*factor*area, a)
+		}
+	}
+}
+
+func TestPathRotate(t *testing.T) {
+	p := NewPath().Push(NewPoint(1, 0)).Push(NewPoint(2, 0)).Push(NewPoint(2, 1))
+	center := NewPoint(1, 0)
+
+	original := p.Clone()
+
+	expected := NewPath().Push(NewPoint(1, 0)).Push(NewPoint(1, 1)).Push(NewPoint(0, 1))
+	r := p.Rotate(90, center)
+	if !p.Equals(original) {
+		t.Errorf("path, rotate should not modify the original, got %v", p)
+	}
+
+	for i := range expected.PointSet {
+		if d := r.GetAt(i).DistanceFrom(expected.GetAt(i)); d > epsilon {
+			t.Errorf("path, rotate 90 expected %v, got %v", expected, r)
+			break
+		}
+	}
+
+	for _, angle := range []float64{360, -360, 720} {
+		r := p.Rotate(angle, NewPoint(-3, 5))
+		for i := range p.PointSet {
+			if d := r.GetAt(i).DistanceFrom(p.GetAt(i)); d > epsilon {
+				t.Errorf("path, rotate %f should return the original, got %v", angle, r)
+				break
 			}
 		}
 	}
 
-	// empty path
-	path := NewPath()
-	if path.Encode() != "" {
-		t.Error("path, encode empty path should be empty string")
+	if d1, d2 := p.Distance(), p.Rotate(33, center).Distance(); math.Abs(d1-d2) > epsilon {
+		t.Errorf("path, rotate should not change the distance, %f != %f", d1, d2)
 	}
 }
 
-func TestPathDistance(t *testing.T) {
+func TestPathWinding(t *testing.T) {
 	p := NewPath()
+	if w := p.Winding(); w != 0 {
+		t.Errorf("path, winding of empty path should be 0, got %d", w)
+	}
+
 	p.Push(NewPoint(0, 0))
-	p.Push(NewPoint(0, 3))
-	p.Push(NewPoint(4, 3))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(0, 1))
 
-	if d := p.Distance(); d != 7 {
-		t.Errorf("path, distance got: %f, expected 7.0", d)
+	if w := p.Winding(); w != 1 {
+		t.Errorf("path, winding expected 1, got %d", w)
 	}
-}
 
-func TestPathDistanceFrom(t *testing.T) {
-	var answer float64
+	// closing the path should not change anything
+	p.Push(NewPoint(0, 0))
+	if w := p.Winding(); w != 1 {
+		t.Errorf("path, winding of closed path expected 1, got %d", w)
+	}
+
+	if w := p.Reverse().Winding(); w != -1 {
+		t.Errorf("path, winding of reversed path expected -1, got %d", w)
+	}
+
+	// degenerate
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(2, 2))
+
+	if w := p.Winding(); w != 0 {
+		t.Errorf("path, winding of collinear path expected 0, got %d", w)
+	}
+}
 
+func TestPathArea(t *testing.T) {
 	p := NewPath()
+	if a := p.Area(); a != 0 {
+		t.Errorf("path, area of empty path should be 0, got %f", a)
+	}
+
 	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(2, 3))
 	p.Push(NewPoint(0, 3))
-	p.Push(NewPoint(4, 3))
+
+	if a := p.Area(); a != 6 {
+		t.Errorf("path, area expected 6, got %f", a)
+	}
+
+	if a := p.Clone().Reverse().Area(); a != -6 {
+		t.Errorf("path, area of reversed path expected -6, got %f", a)
+	}
+
+	// triangle
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
 	p.Push(NewPoint(4, 0))
+	p.Push(NewPoint(0, 4))
+	p.Push(NewPoint(0, 0))
 
-	answer = 0.5
-	if d := p.DistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	if a := p.Area(); a != 8 {
+		t.Errorf("path, area of triangle expected 8, got %f", a)
 	}
+}
 
-	answer = 0.4
-	if d := p.DistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+func TestPathIsClockwise(t *testing.T) {
+	// unit square
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(0, 1)).
+		Push(NewPoint(0, 0))
+
+	if a := p.Area(); a != 1 {
+		t.Errorf("path, area of unit square expected 1, got %f", a)
 	}
 
-	answer = 0.3
-	if d := p.DistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	if p.IsClockwise() {
+		t.Error("path, counter-clockwise square should not be clockwise")
 	}
 
-	answer = 0.2
-	if d := p.DistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	reversed := p.Reverse()
+	if !reversed.IsClockwise() {
+		t.Error("path, reversed square should be clockwise")
+	}
+
+	if a := reversed.Area(); a != -1 {
+		t.Errorf("path, area of reversed unit square expected -1, got %f", a)
+	}
+
+	if NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 1)).IsClockwise() {
+		t.Error("path, degenerate path should not be clockwise")
 	}
 }
 
-func TestPathSquaredDistanceFrom(t *testing.T) {
-	var answer float64
+func TestPathGeoAreaColorado(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-109.05, 37)).
+		Push(NewPoint(-102.05, 37)).
+		Push(NewPoint(-102.05, 41)).
+		Push(NewPoint(-109.05, 41)).
+		Push(NewPoint(-109.05, 37))
 
-	p := NewPath()
-	p.Push(NewPoint(0, 0))
-	p.Push(NewPoint(0, 3))
-	p.Push(NewPoint(4, 3))
-	p.Push(NewPoint(4, 0))
+	// total area of Colorado is 269,837 km^2
+	expected := 269837e6
+	if a := p.GeoArea(); math.Abs(a-expected)/expected > 0.01 {
+		t.Errorf("path, geo area of Colorado expected %f, got %f", expected, a)
+	}
 
-	answer = 0.25
-	if d := p.SquaredDistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	if a := p.Reverse().GeoArea(); math.Abs(a+expected)/expected > 0.01 {
+		t.Errorf("path, geo area of reversed Colorado expected %f, got %f", -expected, a)
 	}
+}
 
-	answer = 0.16
-	if d := p.SquaredDistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+func TestPathGeoArea(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(1, 0))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(0, 1))
+
+	// area of the band between latitude 0 and 1, 1 degree wide
+	expected := EarthRadius * EarthRadius * math.Sin(math.Pi/180) * math.Pi / 180
+	if a := p.GeoArea(); math.Abs(a-expected) > 1 {
+		t.Errorf("path, geo area expected %f, got %f", expected, a)
 	}
 
-	answer = 0.09
-	if d := p.SquaredDistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	if a := p.Reverse().GeoArea(); math.Abs(a+expected) > 1 {
+		t.Errorf("path, geo area of reversed path expected %f, got %f", -expected, a)
 	}
 
-	answer = 0.04
-	if d := p.SquaredDistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
-		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	if a := NewPath().GeoArea(); a != 0 {
+		t.Errorf("path, geo area of empty path should be 0, got %f", a)
 	}
 }
 
-func TestDirectionAt(t *testing.T) {
-	path := NewPath().
+func TestPathCentroid(t *testing.T) {
+	// L shape, the vertex average would be biased towards the corner
+	p := NewPath().
 		Push(NewPoint(0, 0)).
-		Push(NewPoint(0, 1)).
+		Push(NewPoint(4, 0)).
+		Push(NewPoint(4, 1)).
 		Push(NewPoint(1, 1)).
-		Push(NewPoint(1, 0))
+		Push(NewPoint(1, 4)).
+		Push(NewPoint(0, 4))
 
-	// uses two surrounding points so directions are diagonal
-	answers := []float64{0.5 * math.Pi, 0.25 * math.Pi, -0.25 * math.Pi}
-	for i, v := range answers {
-		if d := path.DirectionAt(i); d != v {
-			t.Errorf("path, directionAt, expected %f, got %f", v, d)
-		}
+	expected := NewPoint(19.0/14.0, 19.0/14.0)
+	if c := p.Centroid(); !c.Equals(expected) {
+		t.Errorf("path, centroid expected %v, got %v", expected, c)
 	}
 
-	// INF for single point paths
-	path = NewPath().Push(NewPoint(0, 0))
-	if d := path.DirectionAt(0); d != math.Inf(1) {
-		t.Errorf("path, directionAt expected Inf, got %f", d)
+	if c := p.Reverse().Centroid(); !c.Equals(expected) {
+		t.Errorf("path, centroid of reversed path expected %v, got %v", expected, c)
 	}
-}
 
-func TestPathDirectionAtPanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("path, directionAt invalid geometry should panic")
-		}
-	}()
+	p = NewPath().
+		Push(NewPoint(10, 10)).
+		Push(NewPoint(12, 10)).
+		Push(NewPoint(12, 12)).
+		Push(NewPoint(10, 12)).
+		Push(NewPoint(10, 10))
 
-	// these should panic
-	NewPath().DirectionAt(0)
+	expected = NewPoint(11, 11)
+	if c := p.Centroid(); !c.Equals(expected) {
+		t.Errorf("path, centroid of closed square expected %v, got %v", expected, c)
+	}
+
+	// degenerate
+	p = NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(5, 5))
+
+	expected = NewPoint(2, 2)
+	if c := p.Centroid(); !c.Equals(expected) {
+		t.Errorf("path, centroid of degenerate path expected %v, got %v", expected, c)
+	}
 }
 
-func TestPathMeasure(t *testing.T) {
+func TestPathContains(t *testing.T) {
 	p := NewPath()
+	if p.Contains(NewPoint(0, 0)) {
+		t.Error("path, empty path should not contain anything")
+	}
+
 	p.Push(NewPoint(0, 0))
-	p.Push(NewPoint(6, 8))
-	p.Push(NewPoint(12, 0))
+	p.Push(NewPoint(2, 0))
+	p.Push(NewPoint(2, 2))
+	p.Push(NewPoint(0, 2))
+
+	tests := []struct {
+		Point  *Point
+		Answer bool
+	}{
+		{NewPoint(1, 1), true},
+		{NewPoint(0.1, 1.9), true},
+		{NewPoint(3, 1), false},
+		{NewPoint(-1, 1), false},
+		{NewPoint(1, 2.5), false},
+		{NewPoint(0, 0), false}, // vertex
+		{NewPoint(2, 2), false}, // vertex
+		{NewPoint(1, 0), false}, // edge
+		{NewPoint(0, 1), false}, // closing edge
+		{NewPoint(3, 0), false}, // in line with edge
+	}
+
+	for i, test := range tests {
+		if v := p.Contains(test.Point); v != test.Answer {
+			t.Errorf("path, contains test %d expected %v, got %v", i, test.Answer, v)
+		}
 
-	result := p.Measure(NewPoint(3, 4))
-	expected := 5.0
-	if result != expected {
-		t.Errorf("path, measure expected %f, got %f", expected, result)
+		if v := p.Clone().Reverse().Contains(test.Point); v != test.Answer {
+			t.Errorf("path, contains reversed test %d expected %v, got %v", i, test.Answer, v)
+		}
 	}
 
-	// coincident with start point
-	result = p.Measure(NewPoint(0, 0))
-	expected = 0.0
-	if result != expected {
-		t.Errorf("path, measure expected %f, got %f", expected, result)
+	// self intersecting bowtie, even-odd rule
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(4, 0))
+	p.Push(NewPoint(0, 4))
+	p.Push(NewPoint(4, 4))
+
+	if !p.Contains(NewPoint(2, 1)) {
+		t.Error("path, bowtie should contain bottom triangle")
 	}
 
-	// coincident with end point
-	result = p.Measure(NewPoint(12, 0))
-	expected = 20.0
-	if result != expected {
-		t.Errorf("path, measure expected %f, got %f", expected, result)
+	if p.Contains(NewPoint(1, 2)) {
+		t.Error("path, bowtie should not contain side")
+	}
+
+	// antimeridian crossing, with continuous longitudes
+	p = NewPath()
+	p.Push(NewPoint(170, -10))
+	p.Push(NewPoint(190, -10))
+	p.Push(NewPoint(190, 10))
+	p.Push(NewPoint(170, 10))
+
+	if !p.Contains(NewPoint(-175+360, 0)) {
+		t.Error("path, should contain point across the antimeridian")
+	}
+
+	if p.Contains(NewPoint(0, 0)) {
+		t.Error("path, should not contain point on the other side of the world")
+	}
+}
+
+func TestPathContainsWithBoundary(t *testing.T) {
+	// concave fence, a U shape, not closed
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(3, 0)).
+		Push(NewPoint(3, 3)).
+		Push(NewPoint(2, 3)).
+		Push(NewPoint(2, 1)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(1, 3)).
+		Push(NewPoint(0, 3))
+
+	tests := []struct {
+		Point             *Point
+		Contains          bool
+		ContainsWithBound bool
+	}{
+		{NewPoint(0.5, 2), true, true},
+		{NewPoint(2.5, 2), true, true},
+		{NewPoint(1.5, 2), false, false}, // in the notch
+		{NewPoint(1.5, 0.5), true, true},
+		{NewPoint(-1, 1), false, false},  // ray passes through vertices and a horizontal edge
+		{NewPoint(-1, 3), false, false},  // ray along the top edges
+		{NewPoint(0.5, 1), true, true},   // ray through the bottom of the notch
+		{NewPoint(1.5, 3), false, false}, // ray along the top, in the notch
+		{NewPoint(1.5, 1), false, true},  // on horizontal edge
+		{NewPoint(0, 1.5), false, true},  // on closing edge
+		{NewPoint(2, 3), false, true},    // on vertex
+		{NewPoint(3, 3), false, true},    // on vertex
+	}
+
+	for i, test := range tests {
+		if v := p.Contains(test.Point); v != test.Contains {
+			t.Errorf("path, contains test %d expected %v, got %v", i, test.Contains, v)
+		}
+
+		if v := p.ContainsWithBoundary(test.Point); v != test.ContainsWithBound {
+			t.Errorf("path, contains with boundary test %d expected %v, got %v", i, test.ContainsWithBound, v)
+		}
+
+		closed := p.Clone().Close()
+		if v := closed.ContainsWithBoundary(test.Point); v != test.ContainsWithBound {
+			t.Errorf("path, contains with boundary of closed path test %d expected %v, got %v", i, test.ContainsWithBound, v)
+		}
+	}
+}
+
+func TestPathReverse(t *testing.T) {
+	p := NewPath()
+	if p.Reverse().Length() != 0 {
+		t.Error("path, reverse of empty path should be empty")
+	}
+
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4180, 37.7850))
+	p.Push(NewPoint(-122.4200, 37.7801))
+	p.Push(NewPoint(-122.4260, 37.7820))
+
+	original := p.Clone()
+	reversed := p.Reverse()
+
+	if !p.Equals(original) {
+		t.Errorf("path, reverse should not modify the original, got %v", p)
+	}
+
+	if !reversed.GetAt(0).Equals(original.GetAt(3)) || !reversed.GetAt(3).Equals(original.GetAt(0)) {
+		t.Errorf("path, reverse incorrect, got %v", reversed)
+	}
+
+	if !reversed.GetAt(1).Equals(original.GetAt(2)) || !reversed.GetAt(2).Equals(original.GetAt(1)) {
+		t.Errorf("path, reverse incorrect, got %v", reversed)
+	}
+
+	if d1, d2 := original.GeoDistance(true), reversed.GeoDistance(true); math.Abs(d1-d2) > epsilon {
+		t.Errorf("path, reverse should not change geo distance, %f != %f", d1, d2)
+	}
+
+	if !reversed.Reverse().Equals(original) {
+		t.Errorf("path, reverse twice should be the original, got %v", reversed)
+	}
+}
+
+func TestPathIsRingClose(t *testing.T) {
+	paths := []*Path{
+		NewPath(),
+		NewPath().Push(NewPoint(1, 1)),
+		NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 1)),
+		NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0)).Push(NewPoint(1, 1)),
+		NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0)).Push(NewPoint(1, 1)).Push(NewPoint(0, 0)),
+	}
+
+	rings := []bool{false, false, false, false, true}
+	for i, p := range paths {
+		if v := p.IsRing(); v != rings[i] {
+			t.Errorf("path, is ring test %d expected %v, got %v", i, rings[i], v)
+		}
+	}
+
+	for i, p := range paths[1:] {
+		if !p.Close().IsRing() {
+			t.Errorf("path, close test %d should make a ring, got %v", i, p)
+		}
+
+		length := p.Length()
+		if l := p.Close().Length(); l != length {
+			t.Errorf("path, close test %d should be idempotent, %d != %d", i, l, length)
+		}
+	}
+
+	if p := NewPath().Close(); p.Length() != 0 || p.IsRing() {
+		t.Errorf("path, close of empty path should do nothing, got %v", p)
+	}
+}
+
+func TestPathRemoveDuplicates(t *testing.T) {
+	NewPath().RemoveDuplicates() // should not panic
+
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 0))
+
+	expected := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0))
+
+	if p.RemoveDuplicates(); !p.Equals(expected) {
+		t.Errorf("path, remove duplicates expected %v, got %v", expected, p)
+	}
+}
+
+func TestPathRemoveDuplicatesFuzzy(t *testing.T) {
+	NewPath().RemoveDuplicatesFuzzy(1) // should not panic
+
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(0.05, 0)).
+		Push(NewPoint(0.1, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0.1)).
+		Push(NewPoint(2, 0))
+
+	expected := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0))
+
+	if r := p.Clone().RemoveDuplicatesFuzzy(0.1); !r.Equals(expected) {
+		t.Errorf("path, remove duplicates fuzzy expected %v, got %v", expected, r)
+	}
+
+	if l := p.RemoveDuplicatesFuzzy(0).Length(); l != 6 {
+		t.Errorf("path, remove duplicates fuzzy with zero epsilon should only remove exact duplicates, got %d", l)
+	}
+}
+
+func TestPathConcat(t *testing.T) {
+	p1 := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0))
+	p2 := NewPath().Push(NewPoint(1, 0)).Push(NewPoint(2, 0))
+	p3 := NewPath().Push(NewPoint(3, 0))
+
+	p := p1.Concat(p2, NewPath(), p3)
+	expected := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(3, 0))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, concat expected %v, got %v", expected, p)
+	}
+
+	if p1.Length() != 2 || p2.Length() != 2 {
+		t.Errorf("path, concat should not modify the paths")
+	}
+
+	p = NewPath().Concat(p1)
+	if !p.Equals(p1) {
+		t.Errorf("path, concat onto empty expected %v, got %v", p1, p)
+	}
+
+	p = p1.ConcatWithOptions(ConcatOptions{KeepDuplicateJoins: true}, p2, p3)
+	expected = NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(3, 0))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, concat keeping joins expected %v, got %v", expected, p)
+	}
+}
+
+func TestConcatenatePaths(t *testing.T) {
+	p1 := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0))
+	p2 := NewPath().Push(NewPoint(1, 0)).Push(NewPoint(2, 0))
+	p3 := NewPath().Push(NewPoint(2, 1)).Push(NewPoint(3, 1))
+
+	p := ConcatenatePaths(p1, p2, p3)
+	expected := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 1)).
+		Push(NewPoint(3, 1))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, concatenate expected %v, got %v", expected, p)
+	}
+
+	if p1.Length() != 2 {
+		t.Errorf("path, concatenate should not modify the paths")
+	}
+
+	if p := ConcatenatePaths(); p.Length() != 0 {
+		t.Errorf("path, concatenate of nothing should be empty, got %v", p)
+	}
+}
+
+func TestPathSubPath(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(NewPoint(float64(i), 0))
+	}
+
+	tests := []struct {
+		Start, End int
+		Expected   []float64
+	}{
+		{0, 5, []float64{0, 1, 2, 3, 4}},
+		{1, 3, []float64{1, 2}},
+		{-10, 2, []float64{0, 1}},
+		{3, 100, []float64{3, 4}},
+		{3, 3, []float64{}},
+		{4, 2, []float64{}},
+		{10, 20, []float64{}},
+	}
+
+	for i, test := range tests {
+		s := p.SubPath(test.Start, test.End)
+		if s.Length() != len(test.Expected) {
+			t.Errorf("path, sub path test %d expected %v, got %v", i, test.Expected, s)
+			continue
+		}
+
+		for j, x := range test.Expected {
+			if s.GetAt(j).X() != x {
+				t.Errorf("path, sub path test %d expected %v, got %v", i, test.Expected, s)
+				break
+			}
+		}
+	}
+
+	s := p.SubPath(0, 2)
+	s.SetAt(0, NewPoint(10, 10))
+	if !p.GetAt(0).Equals(NewPoint(0, 0)) {
+		t.Errorf("path, modifying sub path should not change the original")
+	}
+}
+
+func TestPathSlice(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 2)).
+		Push(NewPoint(4, 2))
+
+	tests := []struct {
+		Start, End float64
+		Expected   *Path
+	}{
+		{0, 1, p},
+		{-1, 2, p},
+		{0.5, 1, NewPath().Push(NewPoint(2, 1)).Push(NewPoint(2, 2)).Push(NewPoint(4, 2))},
+		{1.0 / 3.0, 2.0 / 3.0, NewPath().Push(NewPoint(2, 0)).Push(NewPoint(2, 2))}, // on vertices
+		{0.25, 0.75, NewPath().Push(NewPoint(1.5, 0)).Push(NewPoint(2, 0)).Push(NewPoint(2, 2)).Push(NewPoint(2.5, 2))},
+		{0.75, 0.25, NewPath().Push(NewPoint(2.5, 2)).Push(NewPoint(2, 2)).Push(NewPoint(2, 0)).Push(NewPoint(1.5, 0))},
+		{0.5, 0.5, NewPath().Push(NewPoint(2, 1)).Push(NewPoint(2, 1))},
+	}
+
+	for i, test := range tests {
+		s := p.Slice(test.Start, test.End)
+		if s.Length() != test.Expected.Length() {
+			t.Errorf("path, slice test %d expected %v, got %v", i, test.Expected, s)
+			continue
+		}
+
+		for j := range s.PointSet {
+			if !s.GetAt(j).Equals(test.Expected.GetAt(j)) {
+				t.Errorf("path, slice test %d expected %v, got %v", i, test.Expected, s)
+				break
+			}
+		}
+	}
+
+	if p.Length() != 4 {
+		t.Errorf("path, slice should not modify the original")
+	}
+
+	if s := NewPath().Slice(0, 1); s.Length() != 0 {
+		t.Errorf("path, slice of empty path should be empty, got %v", s)
+	}
+}
+
+func TestPathSplitAt(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 2)).
+		Push(NewPoint(4, 2))
+
+	for _, fraction := range []float64{0, 0.1, 1.0 / 3.0, 0.5, 0.9, 1} {
+		first, second := p.SplitAt(fraction)
+
+		if d := first.Distance() + second.Distance(); math.Abs(d-p.Distance()) > epsilon {
+			t.Errorf("path, split at %f lengths should sum to %f, got %f", fraction, p.Distance(), d)
+		}
+
+		if !first.GetAt(first.Length() - 1).Equals(second.GetAt(0)) {
+			t.Errorf("path, split at %f halves should share the split point", fraction)
+		}
+
+		if !first.GetAt(0).Equals(p.GetAt(0)) || !second.GetAt(second.Length()-1).Equals(p.GetAt(3)) {
+			t.Errorf("path, split at %f should keep the endpoints", fraction)
+		}
+	}
+
+	first, second := p.SplitAt(0)
+	if first.Length() != 2 || first.Distance() != 0 || !second.Equals(p) {
+		t.Errorf("path, split at 0 incorrect, got %v %v", first, second)
+	}
+
+	first, second = p.SplitAt(1)
+	if second.Length() != 2 || second.Distance() != 0 || !first.Equals(p) {
+		t.Errorf("path, split at 1 incorrect, got %v %v", first, second)
+	}
+}
+
+func TestPathSplitAtPoint(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 2))
+
+	first, second := p.SplitAtPoint(NewPoint(3, 1))
+
+	expected := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(2, 0)).Push(NewPoint(2, 1))
+	if !first.Equals(expected) {
+		t.Errorf("path, split at point expected %v, got %v", expected, first)
+	}
+
+	expected = NewPath().Push(NewPoint(2, 1)).Push(NewPoint(2, 2))
+	if !second.Equals(expected) {
+		t.Errorf("path, split at point expected %v, got %v", expected, second)
+	}
+
+	// zero length path
+	p = NewPath().Push(NewPoint(1, 1))
+	first, second = p.SplitAtPoint(NewPoint(3, 1))
+	if !first.GetAt(0).Equals(NewPoint(1, 1)) || !second.GetAt(0).Equals(NewPoint(1, 1)) {
+		t.Errorf("path, split at point of single point path incorrect, got %v %v", first, second)
+	}
+}
+
+func TestPathEncode(t *testing.T) {
+	for loop := 0; loop < 100; loop++ {
+		p := NewPath()
+		for i := 0; i < 100; i++ {
+			p.Push(&Point{rand.Float64(), rand.Float64()})
+		}
+
+		encoded := p.Encode()
+		for _, c := range encoded {
+			if c < 63 || c > 127 {
+				t.Errorf("path, encode result out of range: %d", c)
+			}
+		}
+	}
+
+	// empty path
+	path := NewPath()
+	if path.Encode() != "" {
+		t.Error("path, encode empty path should be empty string")
+	}
+}
+
+func TestPathDistance(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(4, 3))
+
+	if d := p.Distance(); d != 7 {
+		t.Errorf("path, distance got: %f, expected 7.0", d)
+	}
+}
+
+func TestPathCumulativeDistances(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(3, 4)).Push(NewPoint(3, 4)).Push(NewPoint(3, 10))
+
+	expected := []float64{0, 5, 5, 11}
+	if d := p.CumulativeDistances(); !reflect.DeepEqual(d, expected) {
+		t.Errorf("path, cumulative distances expected %v, got %v", expected, d)
+	}
+
+	if d := NewPath().CumulativeDistances(); len(d) != 0 {
+		t.Errorf("path, cumulative distances of empty path should be empty, got %v", d)
+	}
+
+	if d := NewPath().Push(NewPoint(1, 2)).CumulativeDistances(); !reflect.DeepEqual(d, []float64{0}) {
+		t.Errorf("path, cumulative distances of single point should be [0], got %v", d)
+	}
+}
+
+func TestPathCumulativeGeoDistances(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551)).
+		Push(NewPoint(-122.41758, 37.77637)).
+		Push(NewPoint(-122.41351, 37.77961))
+
+	for _, haversine := range []bool{false, true} {
+		d := p.CumulativeGeoDistances(haversine)
+		if len(d) != p.Length() || d[0] != 0 {
+			t.Fatalf("path, cumulative geo distances incorrect, got %v", d)
+		}
+
+		if total := p.GeoDistance(haversine); math.Abs(d[len(d)-1]-total) > 1e-9 {
+			t.Errorf("path, last cumulative geo distance should be %f, got %f", total, d[len(d)-1])
+		}
+
+		for i := 1; i < len(d); i++ {
+			if seg := p.GetAt(i-1).GeoDistanceFrom(p.GetAt(i), haversine); math.Abs(d[i]-d[i-1]-seg) > 1e-9 {
+				t.Errorf("path, cumulative geo distance %d incorrect, got %f", i, d[i])
+			}
+		}
+	}
+
+	// consistent with point at distance
+	d := p.CumulativeGeoDistances()
+	if point := p.GeoPointAtDistance(d[2]); !point.Equals(p.GetAt(2)) {
+		t.Errorf("path, point at cumulative distance should be the vertex, got %v", point)
+	}
+}
+
+func TestPathPerimeter(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(3, 0)).
+		Push(NewPoint(3, 4))
+
+	if d := p.Perimeter(); d != 12 {
+		t.Errorf("path, perimeter expected 12, got %f", d)
+	}
+
+	p.Push(NewPoint(0, 0))
+	if d := p.Perimeter(); d != 12 {
+		t.Errorf("path, perimeter of closed path expected 12, got %f", d)
+	}
+
+	for _, p := range []*Path{NewPath(), NewPath().Push(NewPoint(1, 1))} {
+		if d := p.Perimeter(); d != 0 {
+			t.Errorf("path, perimeter of short path should be 0, got %f", d)
+		}
+
+		if d := p.GeoPerimeter(); d != 0 {
+			t.Errorf("path, geo perimeter of short path should be 0, got %f", d)
+		}
+	}
+}
+
+func TestPathGeoPerimeter(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 1))
+
+	for _, haversine := range []bool{true, false} {
+		expected := p.GeoDistance(haversine) + NewPoint(1, 1).GeoDistanceFrom(NewPoint(0, 0), haversine)
+		if d := p.GeoPerimeter(haversine); math.Abs(d-expected) > epsilon {
+			t.Errorf("path, geo perimeter expected %f, got %f", expected, d)
+		}
+
+		closed := p.Clone().Push(NewPoint(0, 0))
+		if d := closed.GeoPerimeter(haversine); math.Abs(d-expected) > epsilon {
+			t.Errorf("path, geo perimeter of closed path expected %f, got %f", expected, d)
+		}
+	}
+}
+
+func TestPathDensify(t *testing.T) {
+	p := NewPath()
+	p.Densify(1) // should not panic
+
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(2, 3))
+
+	p.Densify(1.2)
+
+	expected := NewPath()
+	expected.Push(NewPoint(0, 0))
+	expected.Push(NewPoint(0, 1))
+	expected.Push(NewPoint(0, 2))
+	expected.Push(NewPoint(0, 3))
+	expected.Push(NewPoint(0, 3))
+	expected.Push(NewPoint(1, 3))
+	expected.Push(NewPoint(2, 3))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, densify incorrect, got %v", p)
+	}
+
+	// already short enough
+	p = NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 1))
+	if l := p.Densify(1).Length(); l != 2 {
+		t.Errorf("path, densify should not add points, got %d", l)
+	}
+}
+
+func TestPathGeoDensifyNYCToLondon(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(-74.0060, 40.7128))
+	p.Push(NewPoint(-0.1278, 51.5074))
+
+	total := p.GeoDistance(true)
+	p.GeoDensify(100000)
+
+	if l := p.Length(); l != int(math.Ceil(total/100000))+1 {
+		t.Errorf("path, geodensify incorrect number of points, got %d", l)
+	}
+
+	if !p.GetAt(0).Equals(NewPoint(-74.0060, 40.7128)) || !p.GetAt(p.Length()-1).Equals(NewPoint(-0.1278, 51.5074)) {
+		t.Errorf("path, geodensify should keep the original end points")
+	}
+
+	for i := 0; i < p.Length()-1; i++ {
+		if d := p.GetAt(i).GeoDistanceFrom(p.GetAt(i+1), true); d > 100000 {
+			t.Errorf("path, geodensify segment too long: %f", d)
+		}
+	}
+
+	// great circle is shorter and goes further north
+	if d := p.GeoDistance(true); math.Abs(d-total) > 1 {
+		t.Errorf("path, geodensify should follow the great circle, %f != %f", d, total)
+	}
+
+	maxLat := 0.0
+	for _, point := range p.PointSet {
+		maxLat = math.Max(maxLat, point.Lat())
+	}
+
+	if maxLat < 52 {
+		t.Errorf("path, geodensify should curve north, max lat %f", maxLat)
+	}
+}
+
+func TestPathGeoDensify(t *testing.T) {
+	p := NewPath()
+	p.GeoDensify(100) // should not panic
+
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4167, 37.7833))
+	p.Push(NewPoint(-122.4000, 37.7900))
+	p.Push(NewPoint(-122.3800, 37.7900))
+
+	original := p.Clone()
+	p.GeoDensify(100)
+
+	if p.Length() <= original.Length() {
+		t.Errorf("path, geodensify should add points, got %d", p.Length())
+	}
+
+	// must be a superset of the original points, in order
+	j := 0
+	for i := 0; i < p.Length() && j < original.Length(); i++ {
+		if p.GetAt(i).Equals(original.GetAt(j)) {
+			j++
+		}
+	}
+
+	if j != original.Length() {
+		t.Errorf("path, geodensify should keep the original points")
+	}
+
+	for i := 0; i < p.Length()-1; i++ {
+		if d := p.GetAt(i).GeoDistanceFrom(p.GetAt(i+1), true); d > 100+epsilon {
+			t.Errorf("path, geodensify segment too long: %f", d)
+		}
+	}
+}
+
+func TestPathGeoDensifyPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, geodensify with zero distance should panic")
+		}
+	}()
+
+	NewPath().GeoDensify(0)
+}
+
+func TestPathDistanceFrom(t *testing.T) {
+	var answer float64
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(4, 3))
+	p.Push(NewPoint(4, 0))
+
+	answer = 0.5
+	if d := p.DistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.4
+	if d := p.DistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.3
+	if d := p.DistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.2
+	if d := p.DistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, distanceFrom expected %f, got: %f", answer, d)
+	}
+}
+
+func TestPathSquaredDistanceFrom(t *testing.T) {
+	var answer float64
+
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(4, 3))
+	p.Push(NewPoint(4, 0))
+
+	answer = 0.25
+	if d := p.SquaredDistanceFrom(NewPoint(4.5, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.16
+	if d := p.SquaredDistanceFrom(NewPoint(0.4, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.09
+	if d := p.SquaredDistanceFrom(NewPoint(-0.3, 1.5)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+
+	answer = 0.04
+	if d := p.SquaredDistanceFrom(NewPoint(0.3, 2.8)); math.Abs(d-answer) > epsilon {
+		t.Errorf("path, squaredDistanceFrom expected %f, got: %f", answer, d)
+	}
+}
+
+func TestPathNearestVertex(t *testing.T) {
+	p := NewPath()
+	if i, d := p.NearestVertex(NewPoint(0, 0)); i != -1 || !math.IsInf(d, 1) {
+		t.Errorf("path, nearest vertex of empty path should be -1, inf, got %d, %f", i, d)
+	}
+
+	p.Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 10))
+
+	// closest to the middle of the first segment, but nearest vertex is the start
+	if i, d := p.NearestVertex(NewPoint(4, 1)); i != 0 || d != math.Sqrt(17) {
+		t.Errorf("path, nearest vertex expected 0, %f, got %d, %f", math.Sqrt(17), i, d)
+	}
+
+	if i, d := p.NearestVertex(NewPoint(10, 7)); i != 2 || d != 3 {
+		t.Errorf("path, nearest vertex expected 2, 3, got %d, %f", i, d)
+	}
+}
+
+func TestPathGeoNearestVertex(t *testing.T) {
+	p := NewPath()
+	if i, d := p.GeoNearestVertex(NewPoint(0, 0)); i != -1 || !math.IsInf(d, 1) {
+		t.Errorf("path, geo nearest vertex of empty path should be -1, inf, got %d, %f", i, d)
+	}
+
+	// at high latitude a degree of longitude is much shorter than latitude
+	p.Push(NewPoint(0, 60)).Push(NewPoint(1.5, 60)).Push(NewPoint(0, 61))
+
+	point := NewPoint(0.8, 60.8)
+	i, d := p.GeoNearestVertex(point)
+
+	expectedDist, expectedIndex := p.PointSet.GeoDistanceFrom(point)
+	if i != expectedIndex || d != expectedDist {
+		t.Errorf("path, geo nearest vertex expected %d, %f, got %d, %f", expectedIndex, expectedDist, i, d)
+	}
+
+	if i != 2 {
+		t.Errorf("path, geo nearest vertex expected 2, got %d", i)
+	}
+
+	// across the antimeridian
+	p = NewPath().Push(NewPoint(170, 0)).Push(NewPoint(-179.5, 0))
+	point = NewPoint(179.5, 0)
+
+	i, d = p.GeoNearestVertex(point)
+	if expected := NewPoint(179.5, 0).GeoDistanceFrom(NewPoint(180.5, 0)); i != 1 || math.Abs(d-expected) > epsilon {
+		t.Errorf("path, geo nearest vertex across the antimeridian expected 1, %f, got %d, %f", expected, i, d)
+	}
+}
+
+func TestPathNearestVertexRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	p := NewPath()
+	for i := 0; i < 200; i++ {
+		p.Push(NewPoint(-122.5+0.1*r.Float64(), 37.7+0.1*r.Float64()))
+	}
+
+	for i := 0; i < 100; i++ {
+		point := NewPoint(-122.5+0.1*r.Float64(), 37.7+0.1*r.Float64())
+
+		index, dist := p.NearestVertex(point)
+		geoIndex, geoDist := p.GeoNearestVertex(point)
+
+		expectedIndex, expectedGeoIndex := 0, 0
+		for j := range p.PointSet {
+			if p.GetAt(j).DistanceFrom(point) < p.GetAt(expectedIndex).DistanceFrom(point) {
+				expectedIndex = j
+			}
+
+			if p.GetAt(j).GeoDistanceFrom(point) < p.GetAt(expectedGeoIndex).GeoDistanceFrom(point) {
+				expectedGeoIndex = j
+			}
+		}
+
+		if index != expectedIndex || dist != p.GetAt(index).DistanceFrom(point) {
+			t.Errorf("path, nearest vertex expected %d, got %d, %f", expectedIndex, index, dist)
+		}
+
+		// the nearest vertex is found with an approximation,
+		// allow for different vertices at near equal distances.
+		if d := p.GetAt(expectedGeoIndex).GeoDistanceFrom(point); math.Abs(geoDist-d) > 0.01 {
+			t.Errorf("path, geo nearest vertex expected %d, %f, got %d, %f", expectedGeoIndex, d, geoIndex, geoDist)
+		}
+	}
+}
+
+func TestPathVerticesWithin(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0)).Push(NewPoint(2, 0)).Push(NewPoint(3, 0))
+
+	if v := p.VerticesWithin(NewPoint(1.5, 0), 0.5); len(v) != 2 || v[0] != 1 || v[1] != 2 {
+		t.Errorf("path, vertices within expected [1 2], got %v", v)
+	}
+
+	if v := p.VerticesWithin(NewPoint(1.5, 5), 1); len(v) != 0 {
+		t.Errorf("path, vertices within expected none, got %v", v)
+	}
+
+	if v := NewPath().VerticesWithin(NewPoint(0, 0), 1); len(v) != 0 {
+		t.Errorf("path, vertices within of empty path should be empty, got %v", v)
+	}
+}
+
+func TestPathGeoVerticesWithin(t *testing.T) {
+	p := NewPath()
+	for i := 0; i < 10; i++ {
+		p.Push(NewPoint(-122.4+float64(i)*0.001, 37.8))
+	}
+
+	point := NewPoint(-122.395, 37.8)
+	for _, meters := range []float64{50, 100, 300, 1000} {
+		var expected []int
+		for i := range p.PointSet {
+			if p.GetAt(i).GeoDistanceFrom(point) <= meters {
+				expected = append(expected, i)
+			}
+		}
+
+		v := p.GeoVerticesWithin(point, meters)
+		if len(v) != len(expected) {
+			t.Errorf("path, geo vertices within %f expected %v, got %v", meters, expected, v)
+			continue
+		}
+
+		for i := range v {
+			if v[i] != expected[i] {
+				t.Errorf("path, geo vertices within %f expected %v, got %v", meters, expected, v)
+				break
+			}
+		}
+	}
+
+	// across the antimeridian
+	p = NewPath().Push(NewPoint(179.9995, 0)).Push(NewPoint(-179.9995, 0)).Push(NewPoint(-179.99, 0))
+	if v := p.GeoVerticesWithin(NewPoint(180, 0), 100); len(v) != 2 || v[0] != 0 || v[1] != 1 {
+		t.Errorf("path, geo vertices within across the antimeridian expected [0 1], got %v", v)
+	}
+}
+
+func TestDirectionAt(t *testing.T) {
+	path := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(0, 1)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(1, 0))
+
+	// uses two surrounding points so directions are diagonal
+	answers := []float64{0.5 * math.Pi, 0.25 * math.Pi, -0.25 * math.Pi}
+	for i, v := range answers {
+		if d := path.DirectionAt(i); d != v {
+			t.Errorf("path, directionAt, expected %f, got %f", v, d)
+		}
+	}
+
+	// INF for single point paths
+	path = NewPath().Push(NewPoint(0, 0))
+	if d := path.DirectionAt(0); d != math.Inf(1) {
+		t.Errorf("path, directionAt expected Inf, got %f", d)
+	}
+}
+
+func TestPathDirectionAtPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, directionAt invalid geometry should panic")
+		}
+	}()
+
+	// these should panic
+	NewPath().DirectionAt(0)
+}
+
+func TestPathBearingAt(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	if b := p.BearingAt(0); b != 0 {
+		t.Errorf("path, bearing at for single point should be 0, got %f", b)
+	}
+
+	p.Push(NewPoint(0, 1))
+	p.Push(NewPoint(1, 1))
+	p.Push(NewPoint(1.5, 0.5))
+
+	for i := 0; i < p.Length()-1; i++ {
+		if b := p.BearingAt(i); b != p.GetAt(i).BearingTo(p.GetAt(i+1)) {
+			t.Errorf("path, bearing at %d should match BearingTo, got %f", i, b)
+		}
+	}
+
+	if b := p.BearingAt(3); b != p.BearingAt(2) {
+		t.Errorf("path, bearing at last point should be the last segment, got %f", b)
+	}
+
+	if b := p.BearingAt(0); b != 0 {
+		t.Errorf("path, bearing at 0 should be north, got %f", b)
+	}
+
+	if b := p.BearingAt(1); math.Abs(b-90) > 0.01 {
+		t.Errorf("path, bearing at 1 should be east, got %f", b)
+	}
+}
+
+func TestPathBearingAtPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("path, bearing at out of range should panic")
+		}
+	}()
+
+	NewPath().Push(NewPoint(0, 0)).BearingAt(1)
+}
+
+func TestPathBearingAtFraction(t *testing.T) {
+	// east along the equator, then north
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0)). // zero length segment
+		Push(NewPoint(1, 1))
+
+	tests := []struct {
+		Fraction float64
+		Smooth   bool
+		Answer   float64
+	}{
+		{-1, false, 90},
+		{0, false, 90},
+		{0.25, false, 90},
+		{0.75, false, 0},
+		{1, false, 0},
+		{2, false, 0},
+		{0, true, 90},
+		{0.25, true, 90},
+		{1, true, 0},
+	}
+
+	for i, test := range tests {
+		if b := p.BearingAtFraction(test.Fraction, test.Smooth); math.Abs(b-test.Answer) > epsilon {
+			t.Errorf("path, bearing at fraction test %d expected %f, got %f", i, test.Answer, b)
+		}
+	}
+
+	// exactly at the middle vertex
+	half := p.PointSet[0].GeoDistanceFrom(&p.PointSet[1]) / p.GeoDistance()
+	if b := p.BearingAtFraction(half); math.Abs(b-90) > epsilon {
+		t.Errorf("path, bearing at vertex should use incoming segment, got %f", b)
+	}
+
+	if b := p.BearingAtFraction(half, true); math.Abs(b-45) > epsilon {
+		t.Errorf("path, smoothed bearing at vertex expected 45, got %f", b)
+	}
+
+	if b := NewPath().Push(NewPoint(1, 1)).BearingAtFraction(0.5); b != 0 {
+		t.Errorf("path, bearing at fraction for single point should be 0, got %f", b)
+	}
+}
+
+func TestPathBearings(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, -1))
+
+	bearings := p.Bearings()
+	expected := []float64{90, 0, 180}
+
+	if len(bearings) != len(expected) {
+		t.Fatalf("path, bearings expected %v, got %v", expected, bearings)
+	}
+
+	for i := range expected {
+		if math.Abs(bearings[i]-expected[i]) > epsilon {
+			t.Errorf("path, bearings expected %v, got %v", expected, bearings)
+		}
+	}
+
+	if b := NewPath().Bearings(); len(b) != 0 {
+		t.Errorf("path, bearings of empty path should be empty, got %v", b)
+	}
+}
+
+func TestPathMeasure(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(6, 8))
+	p.Push(NewPoint(12, 0))
+
+	result := p.Measure(NewPoint(3, 4))
+	expected := 5.0
+	if result != expected {
+		t.Errorf("path, measure expected %f, got %f", expected, result)
+	}
+
+	// coincident with start point
+	result = p.Measure(NewPoint(0, 0))
+	expected = 0.0
+	if result != expected {
+		t.Errorf("path, measure expected %f, got %f", expected, result)
+	}
+
+	// coincident with end point
+	result = p.Measure(NewPoint(12, 0))
+	expected = 20.0
+	if result != expected {
+		t.Errorf("path, measure expected %f, got %f", expected, result)
 	}
 
 	// closest point on path
@@ -324,6 +1696,110 @@ func TestPathMeasure(t *testing.T) {
 	}
 }
 
+func TestPathPointAtDistance(t *testing.T) {
+	p := NewPath()
+	if point := p.PointAtDistance(1); point != nil {
+		t.Errorf("path, point at distance of empty path should be nil, got %v", point)
+	}
+
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(0, 3))
+	p.Push(NewPoint(4, 3))
+
+	tests := []struct {
+		Distance float64
+		Point    *Point
+	}{
+		{-1, NewPoint(0, 0)},
+		{0, NewPoint(0, 0)},
+		{1.5, NewPoint(0, 1.5)},
+		{3, NewPoint(0, 3)},
+		{5, NewPoint(2, 3)},
+		{7, NewPoint(4, 3)},
+		{10, NewPoint(4, 3)},
+	}
+
+	for _, test := range tests {
+		if point := p.PointAtDistance(test.Distance); !point.Equals(test.Point) {
+			t.Errorf("path, point at distance %f expected %v, got %v", test.Distance, test.Point, point)
+		}
+	}
+
+	if point := p.PointAtDistance(100); point == p.GetAt(3) {
+		t.Error("path, point at distance should return a copy")
+	}
+}
+
+func TestPathGeoPointAtDistance(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(0, 10))
+	p.Push(NewPoint(0, 20))
+
+	d := p.GeoDistance()
+	if point := p.GeoPointAtDistance(d / 4); math.Abs(point.Lat()-5) > epsilon {
+		t.Errorf("path, geo point at distance incorrect, got %v", point)
+	}
+
+	if point := p.GeoPointAtDistance(2 * d); !point.Equals(NewPoint(0, 20)) {
+		t.Errorf("path, geo point at distance incorrect, got %v", point)
+	}
+
+	// consistent with geo resample
+	resampled := p.Clone().GeoResample(7)
+	for i := 0; i < resampled.Length(); i++ {
+		point := p.GeoPointAtDistance(d * float64(i) / 6)
+		if e := point.DistanceFrom(resampled.GetAt(i)); e > epsilon {
+			t.Errorf("path, geo point at distance not consistent with resample, %v != %v", point, resampled.GetAt(i))
+		}
+	}
+}
+
+func TestPathClosestPointTo(t *testing.T) {
+	p := NewPath()
+	p.Push(NewPoint(0, 0))
+	p.Push(NewPoint(6, 8))
+	p.Push(NewPoint(12, 0))
+
+	point, index, fraction := p.ClosestPointTo(NewPoint(3, 4))
+	if expected := NewPoint(3, 4); !point.Equals(expected) {
+		t.Errorf("path, closest point expected %v, got %v", expected, point)
+	}
+
+	if index != 0 {
+		t.Errorf("path, closest point index expected 0, got %d", index)
+	}
+
+	if fraction != 0.5 {
+		t.Errorf("path, closest point fraction expected 0.5, got %f", fraction)
+	}
+
+	point, index, fraction = p.ClosestPointTo(NewPoint(13, -3))
+	if expected := NewPoint(12, 0); !point.Equals(expected) {
+		t.Errorf("path, closest point expected %v, got %v", expected, point)
+	}
+
+	if index != 1 {
+		t.Errorf("path, closest point index expected 1, got %d", index)
+	}
+
+	if fraction != 1 {
+		t.Errorf("path, closest point fraction expected 1, got %f", fraction)
+	}
+
+	// single point and empty paths
+	p = NewPath()
+	if point, index, _ := p.ClosestPointTo(NewPoint(1, 1)); point != nil || index != -1 {
+		t.Errorf("path, closest point of empty path should be nil, got %v %d", point, index)
+	}
+
+	p.Push(NewPoint(1, 2))
+	if point, index, _ := p.ClosestPointTo(NewPoint(1, 1)); !point.Equals(NewPoint(1, 2)) || index != 0 {
+		t.Errorf("path, closest point of single point path incorrect, got %v %d", point, index)
+	}
+}
+
 func TestPathProject(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0, 0))
@@ -407,6 +1883,33 @@ func TestPathIntersectionPath(t *testing.T) {
 	}
 }
 
+func TestPathIntersectionPathOrder(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(10, 0)).Push(NewPoint(10, 10))
+
+	// crosses the first segment at 8, 5 then 2 and the second once
+	path := NewPath().
+		Push(NewPoint(8, -1)).
+		Push(NewPoint(8, 1)).
+		Push(NewPoint(2, -1)).
+		Push(NewPoint(2, 1)).
+		Push(NewPoint(12, 6))
+
+	points, indexes := p.IntersectionPath(path)
+
+	expected := []*Point{NewPoint(2, 0), NewPoint(5, 0), NewPoint(8, 0), NewPoint(10, 5)}
+	expectedIndexes := [][2]int{{0, 2}, {0, 1}, {0, 0}, {1, 3}}
+
+	if len(points) != len(expected) {
+		t.Fatalf("path, intersectionPath expected %v, got %v", expected, points)
+	}
+
+	for i := range expected {
+		if !points[i].Equals(expected[i]) || indexes[i] != expectedIndexes[i] {
+			t.Errorf("path, intersectionPath %d expected %v %v, got %v %v", i, expected[i], expectedIndexes[i], points[i], indexes[i])
+		}
+	}
+}
+
 func TestPathIntersectionLine(t *testing.T) {
 	var line *Line
 	var answer *Point
@@ -481,6 +1984,37 @@ func TestPathIntersectsPath(t *testing.T) {
 	}
 }
 
+func TestPathIntersectsPathGeofence(t *testing.T) {
+	fence := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(10, 0)).
+		Push(NewPoint(10, 10)).
+		Push(NewPoint(0, 10)).
+		Push(NewPoint(0, 0))
+
+	// leaves the fence
+	track := NewPath().Push(NewPoint(5, 5)).Push(NewPoint(8, 6)).Push(NewPoint(12, 7))
+	if !fence.IntersectsPath(track) || !track.IntersectsPath(fence) {
+		t.Errorf("path, track leaving the fence should intersect")
+	}
+
+	// stays inside, the bounds overlap
+	track = NewPath().Push(NewPoint(5, 5)).Push(NewPoint(8, 6)).Push(NewPoint(9, 9))
+	if fence.IntersectsPath(track) || track.IntersectsPath(fence) {
+		t.Errorf("path, track inside the fence should not intersect")
+	}
+
+	// touches the fence at a vertex
+	track = NewPath().Push(NewPoint(5, 5)).Push(NewPoint(10, 10))
+	if !fence.IntersectsPath(track) {
+		t.Errorf("path, track touching the fence should intersect")
+	}
+
+	if fence.IntersectsPath(NewPath()) || NewPath().IntersectsPath(fence) {
+		t.Errorf("path, empty paths should not intersect")
+	}
+}
+
 func TestPathIntersectsLine(t *testing.T) {
 	var line *Line
 	var answer bool
@@ -506,6 +2040,401 @@ func TestPathIntersectsLine(t *testing.T) {
 	}
 }
 
+func TestPathSegments(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0)).Push(NewPoint(1, 1)).Push(NewPoint(0, 1))
+
+	count := 0
+	p.Segments(func(i int, segment Line) bool {
+		if !segment.Equals(p.SegmentAt(i)) || !segment.A().Equals(p.GetAt(i)) || !segment.B().Equals(p.GetAt(i+1)) {
+			t.Errorf("path, segments incorrect segment %d, got %v", i, segment)
+		}
+
+		count++
+		return true
+	})
+
+	if count != 3 {
+		t.Errorf("path, segments expected 3 segments, got %d", count)
+	}
+
+	count = 0
+	p.Segments(func(i int, segment Line) bool {
+		count++
+		return i < 1
+	})
+
+	if count != 2 {
+		t.Errorf("path, segments should stop early, got %d", count)
+	}
+
+	NewPath().Push(NewPoint(0, 0)).Segments(func(i int, segment Line) bool {
+		t.Errorf("path, single point path should not have segments")
+		return true
+	})
+
+	// a closure capturing a local should not allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		sum := 0.0
+		p.Segments(func(i int, segment Line) bool {
+			sum += segment.Distance()
+			return true
+		})
+	})
+
+	if allocs != 0 {
+		t.Errorf("path, segments should not allocate, got %v", allocs)
+	}
+}
+
+func TestPathSegmentAtPanic(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 0))
+
+	for _, i := range []int{-1, 1} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("path, segment at %d should panic", i)
+				}
+			}()
+			p.SegmentAt(i)
+		}()
+	}
+}
+
+func TestPathBoundEdgeCases(t *testing.T) {
+	p := NewPath()
+	if b := p.Bound(); !b.Empty() || !b.Equals(NewBound(0, 0, 0, 0)) {
+		t.Errorf("path, bound of empty path should be empty, got %v", b)
+	}
+
+	p.Push(NewPoint(1, 2))
+	if b := p.Bound(); !b.Empty() || !b.Equals(NewBound(1, 1, 2, 2)) {
+		t.Errorf("path, bound of single point should have zero size, got %v", b)
+	}
+
+	// chaining
+	if c := p.Push(NewPoint(3, 4)).Bound().Center(); !c.Equals(NewPoint(2, 3)) {
+		t.Errorf("path, bound center expected POINT(2 3), got %v", c)
+	}
+
+	// antimeridian
+	p = NewPath().Push(NewPoint(170, 0)).Push(NewPoint(-170, 1))
+	if b := p.Bound(); b.Width() != 340 {
+		t.Errorf("path, bound across antimeridian should span the globe, got %v", b)
+	}
+
+	p = NewPath().Push(NewPoint(170, 0)).Push(NewPoint(190, 1))
+	if b := p.Bound(); b.Width() != 20 {
+		t.Errorf("path, bound with continuous longitudes should be tight, got %v", b)
+	}
+}
+
+func TestPathIntersectsBound(t *testing.T) {
+	bound := NewBound(0, 1, 0, 1)
+
+	tests := []struct {
+		Path   *Path
+		Answer bool
+	}{
+		{NewPath(), false},
+		{NewPath().Push(NewPoint(0.5, 0.5)), true},
+		{NewPath().Push(NewPoint(2, 2)).Push(NewPoint(3, 3)), false},
+		{NewPath().Push(NewPoint(-1, -1)).Push(NewPoint(0.5, 0.5)), true},
+		{NewPath().Push(NewPoint(-1, 0.5)).Push(NewPoint(2, 0.5)), true}, // crosses through
+		{NewPath().Push(NewPoint(1, -1)).Push(NewPoint(1, 2)), true},     // along an edge
+		{NewPath().Push(NewPoint(-1, 1)).Push(NewPoint(1, -1)), true},    // touches corner
+		// bounds overlap but the geometry does not
+		{NewPath().Push(NewPoint(-1, 2)).Push(NewPoint(2, 2)).Push(NewPoint(2, -1)), false},
+		{NewPath().Push(NewPoint(-1, 3.5)).Push(NewPoint(3.5, -1)), false},
+	}
+
+	for i, test := range tests {
+		if v := test.Path.IntersectsBound(bound); v != test.Answer {
+			t.Errorf("path, intersects bound test %d expected %v, got %v", i, test.Answer, v)
+		}
+
+		if v := test.Path.Intersects(bound); v != test.Answer {
+			t.Errorf("path, intersects with bound test %d expected %v, got %v", i, test.Answer, v)
+		}
+	}
+}
+
+func TestPathMutations(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 2)).
+		InsertAt(1, NewPoint(1, 1)).
+		InsertAt(3, NewPoint(3, 3)).
+		SetAt(0, NewPoint(-1, -1)).
+		RemoveAt(3)
+
+	expected := NewPath().
+		Push(NewPoint(-1, -1)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(2, 2))
+
+	if !p.Equals(expected) {
+		t.Errorf("path, mutations expected %v, got %v", expected, p)
+	}
+
+	if point := p.Pop(); !point.Equals(NewPoint(2, 2)) {
+		t.Errorf("path, pop expected last point, got %v", point)
+	}
+
+	p.Pop()
+	p.Pop()
+	if point := p.Pop(); point != nil {
+		t.Errorf("path, pop of empty path should return nil, got %v", point)
+	}
+}
+
+func TestPathMutationsPanic(t *testing.T) {
+	p := NewPath().Push(NewPoint(0, 0))
+
+	for i, f := range []func(){
+		func() { p.SetAt(1, NewPoint(1, 1)) },
+		func() { p.SetAt(-1, NewPoint(1, 1)) },
+		func() { p.InsertAt(2, NewPoint(1, 1)) },
+		func() { p.InsertAt(-1, NewPoint(1, 1)) },
+		func() { p.RemoveAt(1) },
+		func() { p.RemoveAt(-1) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("path, expected panic for out of range index, test %d", i)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestPathEqualsNil(t *testing.T) {
+	var p1, p2 *Path
+	p := NewPath().Push(NewPoint(1, 1))
+
+	if !p1.Equals(p2) || !p1.ApproxEquals(p2, 1) {
+		t.Error("path, nil paths should be equal")
+	}
+
+	if p.Equals(p1) || p1.Equals(p) || p.ApproxEquals(p1, 1) || p1.ApproxEquals(p, 1) {
+		t.Error("path, nil path should not equal non-nil path")
+	}
+}
+
+func TestPathApproxEquals(t *testing.T) {
+	p1 := NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 1)).Push(NewPoint(2, 0))
+	p2 := NewPath().Push(NewPoint(0, 0.01)).Push(NewPoint(1.01, 1)).Push(NewPoint(2, 0))
+
+	if !p1.ApproxEquals(p2, 0.02) {
+		t.Error("path, approx equals should be true within tolerance")
+	}
+
+	if p1.ApproxEquals(p2, 0.001) {
+		t.Error("path, approx equals should be false outside tolerance")
+	}
+
+	if p1.ApproxEquals(p2.Clone().Push(NewPoint(3, 3)), 1) {
+		t.Error("path, approx equals should be false for different lengths")
+	}
+
+	reversed := p2.Clone().Reverse()
+	if p1.ApproxEquals(reversed, 0.02) {
+		t.Error("path, approx equals should be false for reversed path by default")
+	}
+
+	if !p1.ApproxEquals(reversed, 0.02, true) {
+		t.Error("path, approx equals should be true for reversed path if allowed")
+	}
+
+	if !NewPath().ApproxEquals(NewPath(), 0) {
+		t.Error("path, empty paths should be approx equal")
+	}
+}
+
+func TestPathEqualsEncodingRoundTrips(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.419416, 37.774929)).
+		Push(NewPoint(-122.418663, 37.775512)).
+		Push(NewPoint(-122.417581, 37.776373))
+
+	if p.Equals(p.Clone().Push(NewPoint(-122.417581, 37.776373))) {
+		t.Error("path, paths of different lengths should not be equal")
+	}
+
+	// binary is lossless
+	data, _ := p.MarshalBinary()
+	decoded := NewPath()
+	if err := decoded.UnmarshalBinary(data); err != nil || !decoded.Equals(p) {
+		t.Errorf("path, binary round trip should be exactly equal, got %v %v", decoded, err)
+	}
+
+	// wkt with limited precision is not
+	decoded, err := NewPathFromWKT(p.ToWKTWithOptions(WKTOptions{Precision: 4}))
+	if err != nil {
+		t.Fatalf("path, from wkt error: %v", err)
+	}
+
+	if decoded.Equals(p) {
+		t.Error("path, wkt round trip with precision 4 should not be exactly equal")
+	}
+
+	if !decoded.ApproxEquals(p, 1e-4) {
+		t.Error("path, wkt round trip with precision 4 should be approx equal")
+	}
+}
+
+func TestPathClone(t *testing.T) {
+	p1 := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(2, 2))
+
+	p2 := p1.Clone()
+	if p1 == p2 {
+		t.Error("path, clone should return different pointers")
+	}
+
+	if !p2.Equals(p1) {
+		t.Error("path, clone should be equal")
+	}
+
+	p2.GetAt(0).SetX(10)
+	p2.SetAt(1, NewPoint(5, 5))
+	p2.Push(NewPoint(3, 3))
+
+	if !p1.GetAt(0).Equals(NewPoint(0, 0)) || !p1.GetAt(1).Equals(NewPoint(1, 1)) || p1.Length() != 3 {
+		t.Errorf("path, modifying clone should not affect original, got %v", p1)
+	}
+}
+
+func TestPathSelfIntersects(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(0, 1))
+
+	if p.SelfIntersects() {
+		t.Error("path, open square should not self intersect")
+	}
+
+	p.Push(NewPoint(0, 0))
+	if p.SelfIntersects() {
+		t.Error("path, closed square should not self intersect")
+	}
+
+	// bowtie
+	p = NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(0, 1)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(0, 0))
+
+	if !p.SelfIntersects() {
+		t.Error("path, bowtie should self intersect")
+	}
+
+	// touches an earlier vertex
+	p = NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(2, 2)).
+		Push(NewPoint(1, 0))
+
+	if !p.SelfIntersects() {
+		t.Error("path, touching an earlier segment should self intersect")
+	}
+
+	for _, p := range []*Path{NewPath(), NewPath().Push(NewPoint(0, 0)).Push(NewPoint(1, 1))} {
+		if p.SelfIntersects() {
+			t.Errorf("path, short path should not self intersect, %v", p)
+		}
+	}
+}
+
+func TestPathSelfIntersections(t *testing.T) {
+	// figure eight
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(2, 2)).
+		Push(NewPoint(2, 0)).
+		Push(NewPoint(0, 2)).
+		Push(NewPoint(0, 0))
+
+	points, indexes := p.SelfIntersections()
+	if len(points) != 1 || len(indexes) != 1 {
+		t.Fatalf("path, figure eight expected 1 intersection, got %v %v", points, indexes)
+	}
+
+	if !points[0].Equals(NewPoint(1, 1)) || indexes[0] != [2]int{0, 2} {
+		t.Errorf("path, figure eight intersection incorrect, got %v %v", points[0], indexes[0])
+	}
+
+	// revisits an earlier vertex exactly
+	p = NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 1)).
+		Push(NewPoint(0, 1)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(2, 0))
+
+	points, indexes = p.SelfIntersections()
+	if len(points) != 4 {
+		t.Fatalf("path, revisited vertex expected 4 intersections, got %v %v", points, indexes)
+	}
+
+	for i := range points {
+		if !points[i].Equals(NewPoint(1, 0)) {
+			t.Errorf("path, revisited vertex intersection incorrect, got %v %v", points[i], indexes[i])
+		}
+	}
+
+	// long spiral track that never crosses itself
+	p = NewPath()
+	for i := 0; i < 2000; i++ {
+		angle := float64(i) * 0.05
+		p.Push(NewPoint(angle*math.Cos(angle), angle*math.Sin(angle)))
+	}
+
+	if points, _ := p.SelfIntersections(); len(points) != 0 {
+		t.Errorf("path, spiral should not self intersect, got %d intersections", len(points))
+	}
+
+	if p.SelfIntersects() {
+		t.Error("path, spiral should not self intersect")
+	}
+
+	// compare to brute force on random paths
+	r := rand.New(rand.NewSource(42))
+	for n := 0; n < 10; n++ {
+		p = NewPath()
+		for i := 0; i < 30; i++ {
+			p.Push(NewPoint(r.Float64(), r.Float64()))
+		}
+
+		expected := 0
+		for i := 0; i < p.Length()-1; i++ {
+			for j := i + 2; j < p.Length()-1; j++ {
+				if NewLine(p.GetAt(i), p.GetAt(i+1)).Intersects(NewLine(p.GetAt(j), p.GetAt(j+1))) {
+					expected++
+				}
+			}
+		}
+
+		if points, _ := p.SelfIntersections(); len(points) != expected {
+			t.Errorf("path, random path expected %d intersections, got %d", expected, len(points))
+		}
+
+		if p.SelfIntersects() != (expected != 0) {
+			t.Errorf("path, random path self intersects should be %v", expected != 0)
+		}
+	}
+}
+
 func TestPathWriteOffFile(t *testing.T) {
 	p := NewPath()
 	p.Push(NewPoint(0, 0))
@@ -539,10 +2468,98 @@ func TestPathToGeoJSON(t *testing.T) {
 	}
 }
 
+func TestPathToGeoJSONGeometry(t *testing.T) {
+	p := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551))
+
+	g := p.ToGeoJSONGeometry()
+	if !g.IsLineString() {
+		t.Errorf("path, should be linestring geometry")
+	}
+
+	if len(g.LineString) != 2 {
+		t.Fatalf("path, geometry should have 2 coordinates, got %d", len(g.LineString))
+	}
+
+	// lng, lat order
+	if g.LineString[0][0] != -122.41942 || g.LineString[0][1] != 37.77493 {
+		t.Errorf("path, geometry coordinate incorrect, got %v", g.LineString[0])
+	}
+
+	p2, err := NewPathFromGeoJSON(g)
+	if err != nil {
+		t.Fatalf("path, from geojson error: %v", err)
+	}
+
+	if !p2.Equals(p) {
+		t.Errorf("path, geojson geometry round trip incorrect, got %v", p2)
+	}
+
+	g = NewPath().ToGeoJSONGeometry()
+	if !g.IsLineString() || len(g.LineString) != 0 {
+		t.Errorf("path, empty path should be empty linestring, got %v", g)
+	}
+}
+
+func TestNewPathFromGeoJSON(t *testing.T) {
+	// part of a route through San Francisco
+	route := NewPath()
+	route.Push(NewPoint(-122.41942, 37.77493))
+	route.Push(NewPoint(-122.41866, 37.77551))
+	route.Push(NewPoint(-122.41758, 37.77637))
+	route.Push(NewPoint(-122.41614, 37.77751))
+	route.Push(NewPoint(-122.41351, 37.77961))
+
+	p, err := NewPathFromGeoJSON(route.ToGeoJSON().Geometry)
+	if err != nil {
+		t.Fatalf("path, from geojson error: %v", err)
+	}
+
+	if !p.Equals(route) {
+		t.Errorf("path, from geojson round trip incorrect, got %v", p)
+	}
+
+	// elevation is dropped
+	g := geojson.NewLineStringGeometry([][]float64{{1, 2, 100}, {3, 4, 200}})
+	p, err = NewPathFromGeoJSON(g)
+	if err != nil {
+		t.Fatalf("path, from geojson error: %v", err)
+	}
+
+	if !p.Equals(NewPath().Push(NewPoint(1, 2)).Push(NewPoint(3, 4))) {
+		t.Errorf("path, from geojson with elevation incorrect, got %v", p)
+	}
+
+	// multipoint and point
+	p, err = NewPathFromGeoJSON(geojson.NewMultiPointGeometry([]float64{1, 2}, []float64{3, 4}))
+	if err != nil || p.Length() != 2 {
+		t.Errorf("path, from geojson multipoint incorrect, got %v %v", p, err)
+	}
+
+	p, err = NewPathFromGeoJSON(geojson.NewPointGeometry([]float64{1, 2}))
+	if err != nil || !p.Equals(NewPath().Push(NewPoint(1, 2))) {
+		t.Errorf("path, from geojson point incorrect, got %v %v", p, err)
+	}
+
+	// unsupported
+	errorTests := []*geojson.Geometry{
+		nil,
+		geojson.NewPolygonGeometry([][][]float64{{{1, 2}, {3, 4}, {1, 2}}}),
+		geojson.NewMultiLineStringGeometry([][]float64{{1, 2}, {3, 4}}),
+	}
+
+	for _, g := range errorTests {
+		if _, err := NewPathFromGeoJSON(g); err != ErrIncorrectGeometry {
+			t.Errorf("path, from geojson should return incorrect geometry error, got %v", err)
+		}
+	}
+}
+
 func TestPathToWKT(t *testing.T) {
 	p := NewPath()
 
-	answer := "EMPTY"
+	answer := "LINESTRING EMPTY"
 	if s := p.ToWKT(); s != answer {
 		t.Errorf("path, string expected %s, got %s", answer, s)
 	}
@@ -558,6 +2575,138 @@ func TestPathToWKT(t *testing.T) {
 	if s := p.ToWKT(); s != answer {
 		t.Errorf("path, string expected %s, got %s", answer, s)
 	}
+
+	// no exponent notation
+	p = NewPath().Push(NewPoint(1e-5, 2.5e7))
+	answer = "LINESTRING(0.00001 25000000)"
+	if s := p.ToWKT(); s != answer {
+		t.Errorf("path, string expected %s, got %s", answer, s)
+	}
+}
+
+func TestNewPathFromWKT(t *testing.T) {
+	tests := []struct {
+		WKT    string
+		Answer *Path
+	}{
+		{"LINESTRING(1 2,3 4)", NewPath().Push(NewPoint(1, 2)).Push(NewPoint(3, 4))},
+		{" linestring ( 1.5 -2 , 3e2 4 ,5 6 ) ", NewPath().Push(NewPoint(1.5, -2)).Push(NewPoint(300, 4)).Push(NewPoint(5, 6))},
+		{"LINESTRING(1 2 3,4 5 6)", NewPath().Push(NewPoint(1, 2)).Push(NewPoint(4, 5))},
+	}
+
+	for _, test := range tests {
+		p, err := NewPathFromWKT(test.WKT)
+		if err != nil {
+			t.Errorf("path, from wkt %s should not error: %v", test.WKT, err)
+			continue
+		}
+
+		if !p.Equals(test.Answer) {
+			t.Errorf("path, from wkt expected %v, got %v", test.Answer, p)
+		}
+	}
+
+	errors := []string{
+		"",
+		"EMPTY",
+		"LINESTRING EMPTY",
+		"LINESTRING(1 2)",
+		"POINT(1 2)",
+		"MULTIPOINT(1 2,3 4)",
+		"LINESTRING(1 2,3 4",
+		"LINESTRING(1 2,3)",
+		"LINESTRING(1 2 3,4 5) extra",
+		"LINESTRING(1 2,a 4)",
+		"LINESTRING(1 2,3 b)",
+	}
+
+	for _, wkt := range errors {
+		p, err := NewPathFromWKT(wkt)
+		if err == nil {
+			t.Errorf("path, from wkt %s should error, got %v", wkt, p)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), fmt.Sprintf("%q", wkt)) {
+			t.Errorf("path, from wkt error should include the input, got %v", err)
+		}
+	}
+}
+
+func TestPathWKTRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	p := NewPath()
+	for i := 0; i < 100; i++ {
+		p.Push(NewPoint(360*r.Float64()-180, 1e-10*r.NormFloat64()))
+	}
+	p.Push(NewPoint(1e21, -1e-21))
+
+	decoded, err := NewPathFromWKT(p.ToWKT())
+	if err != nil {
+		t.Fatalf("path, from wkt should not error: %v", err)
+	}
+
+	if !decoded.Equals(p) {
+		t.Errorf("path, wkt round trip should be exact")
+	}
+
+	decoded, err = NewPathFromWKT(p.ToWKTWithOptions(WKTOptions{Precision: -1}))
+	if err != nil {
+		t.Fatalf("path, from wkt with options should not error: %v", err)
+	}
+
+	if !decoded.Equals(p) {
+		t.Errorf("path, wkt with options round trip should be exact")
+	}
+
+	decoded, err = NewPathFromWKT(p.ToWKTWithOptions(WKTOptions{Precision: 6}))
+	if err != nil {
+		t.Fatalf("path, from wkt with options should not error: %v", err)
+	}
+
+	if !decoded.ApproxEquals(p, 1e-6) {
+		t.Errorf("path, wkt with precision round trip should be within precision")
+	}
+}
+
+func TestPathToWKTWithOptions(t *testing.T) {
+	p := NewPath()
+
+	tests := []struct {
+		Options WKTOptions
+		Answer  string
+	}{
+		{WKTOptions{Precision: -1}, "LINESTRING EMPTY"},
+		{WKTOptions{Precision: 2, AsMultiPoint: true}, "MULTIPOINT EMPTY"},
+	}
+
+	for _, test := range tests {
+		if s := p.ToWKTWithOptions(test.Options); s != test.Answer {
+			t.Errorf("path, wkt with options expected %s, got %s", test.Answer, s)
+		}
+	}
+
+	p.Push(NewPoint(1, 2))
+	p.Push(NewPoint(-122.419416, 0.000001))
+	p.Push(NewPoint(1e7, 3.14159))
+
+	tests = []struct {
+		Options WKTOptions
+		Answer  string
+	}{
+		{WKTOptions{Precision: -1}, "LINESTRING(1 2,-122.419416 0.000001,10000000 3.14159)"},
+		{WKTOptions{Precision: 2}, "LINESTRING(1.00 2.00,-122.42 0.00,10000000.00 3.14)"},
+		{WKTOptions{Precision: 1, AsMultiPoint: true}, "MULTIPOINT(1.0 2.0,-122.4 0.0,10000000.0 3.1)"},
+		{WKTOptions{Precision: 0}, "LINESTRING(1 2,-122 0,10000000 3)"},
+		{WKTOptions{Precision: -1, AsMultiPoint: true}, "MULTIPOINT(1 2,-122.419416 0.000001,10000000 3.14159)"},
+	}
+
+	for _, test := range tests {
+		if s := p.ToWKTWithOptions(test.Options); s != test.Answer {
+			t.Errorf("path, wkt with options expected %s, got %s", test.Answer, s)
+		}
+	}
 }
 
 func TestPathString(t *testing.T) {
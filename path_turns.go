@@ -0,0 +1,55 @@
+package geo
+
+import "math"
+
+// Turn represents a significant change in direction along a path.
+type Turn struct {
+	// Index of the vertex in the path where the turn happens.
+	Index int
+	Point Point
+
+	// Angle is the change in bearing, in degrees, range (-180, 180].
+	// Negative values are left turns, positive are right turns.
+	Angle float64
+}
+
+// Turns returns the vertices where the bearing of the path changes by at least
+// minAngleDegrees. Vertices within minSegmentMeters of the previous kept vertex
+// are skipped so small jitter segments do not affect the bearings.
+// The path must be in lng/lat (EPSG:4326).
+func (p *Path) Turns(minAngleDegrees, minSegmentMeters float64) []Turn {
+	var turns []Turn
+
+	if len(p.PointSet) < 3 {
+		return turns
+	}
+
+	// indexes of the vertices far enough apart to compute bearings
+	kept := []int{0}
+	for i := 1; i < len(p.PointSet); i++ {
+		last := &p.PointSet[kept[len(kept)-1]]
+		if p.PointSet[i].GeoDistanceFrom(last) >= minSegmentMeters && p.PointSet[i] != *last {
+			kept = append(kept, i)
+		}
+	}
+
+	for k := 1; k < len(kept)-1; k++ {
+		prev, current, next := &p.PointSet[kept[k-1]], &p.PointSet[kept[k]], &p.PointSet[kept[k+1]]
+
+		angle := current.BearingTo(next) - prev.BearingTo(current)
+		angle = math.Mod(angle+540, 360) - 180
+		if angle == -180 {
+			angle = 180
+		}
+
+		if math.Abs(angle) >= minAngleDegrees {
+			turns = append(turns, Turn{
+				Index: kept[k],
+				Point: *current,
+				Angle: angle,
+			})
+		}
+	}
+
+	return turns
+}
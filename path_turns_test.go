@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPathTurns(t *testing.T) {
+	if turns := NewPath().Turns(30, 0); len(turns) != 0 {
+		t.Errorf("path, turns of empty path should be empty, got %v", turns)
+	}
+
+	// city grid, about 100m blocks: north, right to east, left to north, left to west
+	block := 0.001
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(0, block)).
+		Push(NewPoint(0, 2*block)).
+		Push(NewPoint(block, 2*block)).
+		Push(NewPoint(block+0.000002, 2*block+0.000001)). // jitter
+		Push(NewPoint(block, 3*block)).
+		Push(NewPoint(0, 3*block))
+
+	expected := []Turn{
+		{Index: 2, Point: Point{0, 2 * block}, Angle: 90},
+		{Index: 3, Point: Point{block, 2 * block}, Angle: -90},
+		{Index: 5, Point: Point{block, 3 * block}, Angle: -90},
+	}
+
+	turns := p.Turns(60, 1)
+	if len(turns) != len(expected) {
+		t.Fatalf("path, turns expected %v, got %v", expected, turns)
+	}
+
+	for i := range expected {
+		if turns[i].Index != expected[i].Index || turns[i].Point != expected[i].Point ||
+			math.Abs(turns[i].Angle-expected[i].Angle) > 0.01 {
+			t.Errorf("path, turn expected %v, got %v", expected[i], turns[i])
+		}
+	}
+
+	// without skipping the jitter the left turn is split in two, only one is big enough
+	if turns := p.Turns(60, 0); len(turns) != 3 || turns[1].Index != 4 {
+		t.Errorf("path, turns without skipping jitter incorrect, got %v", turns)
+	}
+
+	// smooth highway curve, turning 90 degrees over many vertices
+	p = NewPath()
+	for i := 0; i <= 30; i++ {
+		angle := float64(i) * math.Pi / 60
+		p.Push(NewPoint(0.01*math.Cos(angle), 0.01*math.Sin(angle)))
+	}
+
+	if turns := p.Turns(60, 1); len(turns) != 0 {
+		t.Errorf("path, smooth curve should not have turns, got %v", turns)
+	}
+
+	if turns := p.Turns(1, 1); len(turns) != 29 || turns[0].Angle > 0 {
+		t.Errorf("path, smooth curve should have small left turns at a low threshold, got %v", turns)
+	}
+}
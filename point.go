@@ -32,17 +32,23 @@ func NewPointFromLatLng(lat, lng float64) *Point {
 // NewPointFromQuadkey creates a new point from a quadkey.
 // See http://msdn.microsoft.com/en-us/library/bb259689.aspx for more information
 // about this coordinate system.
+// The point is the north west corner of the quadkey tile, see NewBoundFromQuadkey.
 func NewPointFromQuadkey(key int64, level int) *Point {
-	var x, y int64
+	x, y := quadkeyTile(key, level)
 
+	lng, lat := scalarMercatorInverse(x, y, uint64(level))
+	return &Point{lng, lat}
+}
+
+// quadkeyTile returns the x, y tile of the quadkey, with y increasing to the south.
+func quadkeyTile(key int64, level int) (x, y uint64) {
 	var i uint
 	for i = 0; i < uint(level); i++ {
-		x |= (key & (1 << (2 * i))) >> i
-		y |= (key & (1 << (2*i + 1))) >> (i + 1)
+		x |= uint64((key & (1 << (2 * i))) >> i)
+		y |= uint64((key & (1 << (2*i + 1))) >> (i + 1))
 	}
 
-	lng, lat := scalarMercatorInverse(uint64(x), uint64(y), uint64(level))
-	return &Point{lng, lat}
+	return x, y
 }
 
 // NewPointFromQuadkeyString creates a new point from a quadkey string.
@@ -53,15 +59,13 @@ func NewPointFromQuadkeyString(key string) *Point {
 
 // NewPointFromGeoHash creates a new point at the center of the geohash range.
 func NewPointFromGeoHash(hash string) *Point {
-	west, east, south, north := geoHash2ranges(hash)
-	return NewPoint((west+east)/2.0, (north+south)/2.0)
+	return NewBoundFromGeoHash(hash).Center()
 }
 
 // NewPointFromGeoHashInt64 creates a new point at the center of the
 // integer version of a geohash range. bits indicates the precision of the hash.
 func NewPointFromGeoHashInt64(hash int64, bits int) *Point {
-	west, east, south, north := geoHashInt2ranges(hash, bits)
-	return NewPoint((west+east)/2.0, (north+south)/2.0)
+	return NewBoundFromGeoHashInt64(hash, bits).Center()
 }
 
 // Point returns itself, so it implements the pointer interface.
@@ -123,12 +127,61 @@ func (p *Point) BearingTo(point *Point) float64 {
 	return rad2deg(math.Atan2(y, x))
 }
 
+// Buffer returns a new polygon, a regular n-gon with the given number of
+// segments, approximating the circle of the given radius around the point.
+// The ring is closed and counter clockwise. At least 3 segments are used.
+// Assumes euclidean geometry.
+func (p *Point) Buffer(radius float64, segments int) *Polygon {
+	if segments < 3 {
+		segments = 3
+	}
+
+	ring := NewPathPreallocate(0, segments+1)
+	for i := 0; i < segments; i++ {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(segments))
+		ring.Push(&Point{p[0] + radius*cos, p[1] + radius*sin})
+	}
+
+	return NewPolygon(ring.Close())
+}
+
+// GeoBuffer returns a new polygon, with the given number of segments,
+// approximating the geodesic circle of the given radius in meters around
+// the lng/lat point. The ring is closed and counter clockwise. Longitudes
+// are continuous around the center, so may be outside [-180, 180].
+// At least 3 segments are used.
+func (p *Point) GeoBuffer(meters float64, segments int) *Polygon {
+	if segments < 3 {
+		segments = 3
+	}
+
+	lat := deg2rad(p.Lat())
+	dist := meters / EarthRadius
+
+	ring := NewPathPreallocate(0, segments+1)
+	for i := 0; i < segments; i++ {
+		// bearings are clockwise from north, start east going counter clockwise
+		bearing := math.Pi/2 - 2*math.Pi*float64(i)/float64(segments)
+
+		destLat := math.Asin(math.Sin(lat)*math.Cos(dist) + math.Cos(lat)*math.Sin(dist)*math.Cos(bearing))
+		dLng := math.Atan2(math.Sin(bearing)*math.Sin(dist)*math.Cos(lat), math.Cos(dist)-math.Sin(lat)*math.Sin(destLat))
+
+		ring.Push(&Point{p.Lng() + rad2deg(dLng), rad2deg(destLat)})
+	}
+
+	return NewPolygon(ring.Close())
+}
+
 // Quadkey returns the quad key for the given point at the provided level.
 // See http://msdn.microsoft.com/en-us/library/bb259689.aspx for more information
 // about this coordinate system.
 func (p *Point) Quadkey(level int) int64 {
 	x, y := scalarMercatorProject(p.Lng(), p.Lat(), uint64(level))
+	return tileQuadkey(x, y, level)
+}
 
+// tileQuadkey returns the quadkey of the x, y tile, with y increasing to the south.
+func tileQuadkey(x, y uint64, level int) int64 {
 	var i uint
 	var result uint64
 	for i = 0; i < uint(level); i++ {
@@ -161,10 +214,15 @@ func (p *Point) GeoHash(chars ...int) string {
 		precision = chars[0]
 	}
 
+	return geoHashString(p.GeoHashInt64(5*precision), precision)
+}
+
+// geoHashString returns the base32 encoding of the integer geohash
+// of the given number of characters.
+func geoHashString(hash int64, precision int) string {
 	// 15 must be greater than GeoHashPrecision. If not, panic!!
 	var result [15]byte
 
-	hash := p.GeoHashInt64(5 * precision)
 	for i := 1; i <= precision; i++ {
 		result[precision-i] = byte(base32[hash&0x1F])
 		hash >>= 5
@@ -182,6 +182,77 @@ func TestPointBearingTo(t *testing.T) {
 	}
 }
 
+func TestPointBuffer(t *testing.T) {
+	p := NewPoint(1, 2)
+	b := p.Buffer(3, 16)
+
+	ring := b.Exterior()
+	if ring.Length() != 17 || !ring.IsRing() {
+		t.Fatalf("point, buffer should be a closed ring of 17 points, got %d", ring.Length())
+	}
+
+	for _, v := range ring.PointSet {
+		if d := v.DistanceFrom(p); math.Abs(d-3) > epsilon {
+			t.Errorf("point, buffer vertex should be at the radius, got %f", d)
+		}
+	}
+
+	if ring.IsClockwise() {
+		t.Errorf("point, buffer should be counter clockwise")
+	}
+
+	if !b.Contains(p) || !b.Contains(NewPoint(3.8, 2)) || b.Contains(NewPoint(4.1, 2)) {
+		t.Errorf("point, buffer contains incorrect")
+	}
+
+	if l := p.Buffer(3, 1).Exterior().Length(); l != 4 {
+		t.Errorf("point, buffer should use at least 3 segments, got %d points", l)
+	}
+}
+
+func TestPointGeoBuffer(t *testing.T) {
+	p := NewPoint(-122.4194, 37.7749)
+	b := p.GeoBuffer(500, 32)
+
+	ring := b.Exterior()
+	if ring.Length() != 33 || !ring.IsRing() {
+		t.Fatalf("point, geo buffer should be a closed ring of 33 points, got %d", ring.Length())
+	}
+
+	for _, v := range ring.PointSet {
+		if d := v.GeoDistanceFrom(p, true); math.Abs(d-500) > 1e-6 {
+			t.Errorf("point, geo buffer vertex should be 500 meters away, got %f", d)
+		}
+	}
+
+	if ring.IsClockwise() {
+		t.Errorf("point, geo buffer should be counter clockwise")
+	}
+
+	// about pi r^2, a bit less for the polygon
+	if a := b.GeoArea(); a > math.Pi*500*500 || a < 0.99*math.Pi*500*500 {
+		t.Errorf("point, geo buffer area incorrect, got %f", a)
+	}
+
+	// inside and outside north and east
+	lat := 495 / EarthRadius * 180 / math.Pi
+	lng := lat / math.Cos(deg2rad(p.Lat()))
+
+	if !b.Contains(NewPoint(p.Lng(), p.Lat()+lat)) || !b.Contains(NewPoint(p.Lng()+lng, p.Lat())) {
+		t.Errorf("point, geo buffer should contain points 495 meters away")
+	}
+
+	if b.Contains(NewPoint(p.Lng(), p.Lat()+1.02*lat)) || b.Contains(NewPoint(p.Lng()+1.02*lng, p.Lat())) {
+		t.Errorf("point, geo buffer should not contain points 505 meters away")
+	}
+
+	// longitudes are continuous across the anti-meridian
+	b = NewPoint(179.999, 0).GeoBuffer(1000, 8)
+	if bound := b.Bound(); bound.East() < 180 || bound.West() > 180 {
+		t.Errorf("point, geo buffer should cross 180, got %v", bound)
+	}
+}
+
 func TestPointAddSubtract(t *testing.T) {
 	var answer *Point
 	p1 := NewPoint(1, 2)
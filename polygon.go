@@ -0,0 +1,292 @@
+package geo
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/paulmach/go.geojson"
+)
+
+// Polygon represents an area bounded by an exterior ring, with zero
+// or more interior rings, holes, removed from it. Rings are paths that
+// should be closed, ie. the last point repeats the first.
+type Polygon struct {
+	exterior *Path
+	holes    []*Path
+}
+
+// NewPolygon creates a new polygon with the given exterior ring and holes.
+// The paths are not copied.
+func NewPolygon(exterior *Path, holes ...*Path) *Polygon {
+	return &Polygon{
+		exterior: exterior,
+		holes:    holes,
+	}
+}
+
+// Exterior returns the exterior ring of the polygon.
+func (p *Polygon) Exterior() *Path {
+	return p.exterior
+}
+
+// Holes returns the interior rings of the polygon.
+func (p *Polygon) Holes() []*Path {
+	return p.holes
+}
+
+// AddHole adds an interior ring to the polygon.
+func (p *Polygon) AddHole(hole *Path) *Polygon {
+	p.holes = append(p.holes, hole)
+	return p
+}
+
+// Contains returns true if the point is strictly inside the polygon,
+// within the exterior and not within any of the holes. Points on any ring are
+// not contained. Each ring is filled using the non-zero winding rule, the same
+// as Path.Contains for simple rings, so the loops of self overlapping rings,
+// such as from Path.BufferPolygon, are combined. Rings are then combined using
+// the even-odd rule, independent of their winding. Assumes a planar projection.
+func (p *Polygon) Contains(point *Point) bool {
+	winding, boundary := p.exterior.windingNumber(point)
+	if boundary {
+		return false
+	}
+
+	inside := winding != 0
+	for _, hole := range p.holes {
+		winding, boundary := hole.windingNumber(point)
+		if boundary {
+			return false
+		}
+
+		if winding != 0 {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// Area returns the area of the exterior minus the area of the holes.
+// Unlike Path.Area the result is always positive, independent of the
+// winding of the rings. Assumes a planar projection.
+func (p *Polygon) Area() float64 {
+	area := math.Abs(p.exterior.Area())
+	for _, hole := range p.holes {
+		area -= math.Abs(hole.Area())
+	}
+
+	return area
+}
+
+// GeoArea returns the approximate area, in square meters, of the exterior
+// minus the area of the holes. The rings must be in lng/lat (EPSG:4326).
+func (p *Polygon) GeoArea() float64 {
+	area := math.Abs(p.exterior.GeoArea())
+	for _, hole := range p.holes {
+		area -= math.Abs(hole.GeoArea())
+	}
+
+	return area
+}
+
+// Centroid returns the area weighted centroid of the polygon, taking
+// the holes into account. Falls back to the centroid of the exterior
+// for degenerate, zero area, polygons. Assumes a planar projection.
+func (p *Polygon) Centroid() *Point {
+	area := math.Abs(p.exterior.Area())
+	centroid := p.exterior.Centroid().Scale(area)
+
+	for _, hole := range p.holes {
+		a := math.Abs(hole.Area())
+		centroid.Subtract(hole.Centroid().Scale(a))
+		area -= a
+	}
+
+	if area == 0 {
+		return p.exterior.Centroid()
+	}
+
+	return centroid.Scale(1 / area)
+}
+
+// Bound returns the bound of the exterior ring.
+func (p *Polygon) Bound() *Bound {
+	return p.exterior.Bound()
+}
+
+// IsValid returns true if all the rings are closed with at least 4 points
+// and every hole is strictly inside the exterior, without touching it.
+// Holes overlapping each other are not checked.
+func (p *Polygon) IsValid() bool {
+	if !polygonRingIsValid(p.exterior) {
+		return false
+	}
+
+	for _, hole := range p.holes {
+		if !polygonRingIsValid(hole) || p.exterior.IntersectsPath(hole) {
+			return false
+		}
+
+		// no edges cross, so one vertex inside means the whole hole is inside
+		if !p.exterior.Contains(&hole.PointSet[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func polygonRingIsValid(ring *Path) bool {
+	return len(ring.PointSet) >= 4 && ring.PointSet[0] == ring.PointSet[len(ring.PointSet)-1]
+}
+
+// Normalize reverses the rings of the polygon so the exterior is counter clockwise
+// and the holes are clockwise, as required by GeoJSON RFC 7946.
+// Degenerate, zero area, rings are not changed.
+// Use p.Clone().Normalize() to keep the original.
+func (p *Polygon) Normalize() *Polygon {
+	if p.exterior.IsClockwise() {
+		p.exterior = p.exterior.Reverse()
+	}
+
+	for i, hole := range p.holes {
+		if hole.Area() > 0 {
+			p.holes[i] = hole.Reverse()
+		}
+	}
+
+	return p
+}
+
+// IsNormalized returns true if the exterior is not clockwise and
+// the holes are not counter clockwise, see Normalize.
+func (p *Polygon) IsNormalized() bool {
+	if p.exterior.IsClockwise() {
+		return false
+	}
+
+	for _, hole := range p.holes {
+		if hole.Area() > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns if the polygons have the same rings, in the same order.
+func (p *Polygon) Equals(polygon *Polygon) bool {
+	if len(p.holes) != len(polygon.holes) || !p.exterior.Equals(polygon.exterior) {
+		return false
+	}
+
+	for i, hole := range p.holes {
+		if !hole.Equals(polygon.holes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of the polygon.
+func (p *Polygon) Clone() *Polygon {
+	holes := make([]*Path, len(p.holes))
+	for i, hole := range p.holes {
+		holes[i] = hole.Clone()
+	}
+
+	return NewPolygon(p.exterior.Clone(), holes...)
+}
+
+// ToGeoJSON creates a new geojson feature with a polygon geometry.
+// Rings that are not closed are closed, and the rings are wound as
+// required by RFC 7946, see Normalize. The polygon is not modified.
+func (p *Polygon) ToGeoJSON() *geojson.Feature {
+	return geojson.NewFeature(p.ToGeoJSONGeometry())
+}
+
+// ToGeoJSONGeometry creates a new geojson polygon geometry, exterior first,
+// with the points in [lng, lat] order. Rings that are not closed are closed,
+// and the rings are wound as required by RFC 7946. The polygon is not modified.
+func (p *Polygon) ToGeoJSONGeometry() *geojson.Geometry {
+	return p.ToGeoJSONGeometryWithOptions(GeoJSONOptions{})
+}
+
+// GeoJSONOptions control the output of Polygon.ToGeoJSONGeometryWithOptions.
+type GeoJSONOptions struct {
+	// PreserveWinding outputs the rings in their given order,
+	// instead of normalizing them as required by RFC 7946.
+	PreserveWinding bool
+}
+
+// ToGeoJSONGeometryWithOptions creates a new geojson polygon geometry using the given options.
+func (p *Polygon) ToGeoJSONGeometryWithOptions(opts GeoJSONOptions) *geojson.Geometry {
+	rings := make([][][]float64, 0, 1+len(p.holes))
+	for i, ring := range p.rings() {
+		// exterior counter clockwise, holes clockwise
+		reverse := false
+		if !opts.PreserveWinding {
+			area := (&Path{ring}).Area()
+			reverse = (i == 0 && area < 0) || (i != 0 && area > 0)
+		}
+
+		coords := make([][]float64, len(ring))
+		for j, point := range ring {
+			if reverse {
+				j = len(ring) - 1 - j
+			}
+
+			coords[j] = []float64{point[0], point[1]}
+		}
+
+		rings = append(rings, coords)
+	}
+
+	return geojson.NewPolygonGeometry(rings)
+}
+
+// ToWKT returns the polygon in WKT format, eg. POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1)).
+// Rings that are not closed are closed in the output.
+// Polygons with an empty exterior will be 'POLYGON EMPTY'.
+func (p *Polygon) ToWKT() string {
+	if len(p.exterior.PointSet) == 0 {
+		return "POLYGON EMPTY"
+	}
+
+	buff := bytes.NewBuffer(nil)
+	buff.WriteString("POLYGON")
+	writeWKTRings(buff, p.rings())
+
+	return buff.String()
+}
+
+// String returns a string representation of the polygon.
+// The format is WKT, see ToWKT.
+func (p *Polygon) String() string {
+	return p.ToWKT()
+}
+
+// rings returns the points of the non empty rings, exterior first, closed if needed.
+func (p *Polygon) rings() []PointSet {
+	if len(p.exterior.PointSet) == 0 {
+		return nil
+	}
+
+	rings := make([]PointSet, 0, 1+len(p.holes))
+	for _, ring := range append([]*Path{p.exterior}, p.holes...) {
+		ps := ring.PointSet
+		if len(ps) == 0 {
+			continue
+		}
+
+		if ps[0] != ps[len(ps)-1] {
+			ps = append(ps[:len(ps):len(ps)], ps[0])
+		}
+
+		rings = append(rings, ps)
+	}
+
+	return rings
+}
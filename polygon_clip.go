@@ -0,0 +1,422 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrClipHoles is returned by the polygon boolean operations if either polygon has holes.
+	ErrClipHoles = errors.New("go.geo: polygon boolean operations do not support holes")
+
+	// ErrClipDegenerate is returned by the polygon boolean operations if the polygons
+	// touch or overlap in a way that could not be removed by perturbing them.
+	ErrClipDegenerate = errors.New("go.geo: unable to resolve degenerate polygon intersections")
+)
+
+type clipOperation int
+
+const (
+	clipIntersection clipOperation = iota
+	clipUnion
+	clipDifference
+)
+
+const (
+	// clipEpsilon is the fraction of a segment within which intersections
+	// are considered to be at its end points, ie. degenerate.
+	clipEpsilon = 1e-12
+
+	// clipPerturbation is the fraction of the extent of the polygons the clip
+	// polygon is moved by to remove degenerate intersections. Result vertices within
+	// clipSnap of an input vertex are snapped back to it, and the pieces with less than
+	// clipSliver of the extent squared in area are removed.
+	clipPerturbation = 1e-9
+	clipSnap         = 1e-7
+	clipSliver       = 1e-7
+)
+
+// clipVertex is a vertex in the doubly linked lists of the Greiner-Hormann algorithm.
+type clipVertex struct {
+	point    Point // maybe perturbed, for computation
+	original Point // for the output
+
+	next, prev *clipVertex
+	neighbor   *clipVertex
+
+	alpha     float64
+	intersect bool
+	entry     bool
+	visited   bool
+}
+
+// Intersection returns the polygons covering the area inside both polygons.
+// Results are closed and normalized, see Normalize. The polygons are not modified.
+// Holes are not supported, see Union for more details.
+func (p *Polygon) Intersection(other *Polygon) ([]*Polygon, error) {
+	return polygonClip(p, other, clipIntersection)
+}
+
+// Union returns the polygons covering the area inside either polygon. If the
+// polygons overlap the result is a single polygon, that may have holes. Disjoint
+// polygons are returned separately. Results are closed and normalized, see Normalize.
+// The polygons are not modified. Uses the Greiner-Hormann algorithm, shared edges and
+// vertices on the other polygon's edges are handled by perturbing the polygons so
+// features smaller than about 1e-7 of their size may be lost. Polygons must be simple
+// and without holes, returns ErrClipHoles if either has holes. Returns ErrClipDegenerate
+// if the perturbation does not remove the degenerate intersections.
+// Assumes a planar projection.
+func (p *Polygon) Union(other *Polygon) ([]*Polygon, error) {
+	return polygonClip(p, other, clipUnion)
+}
+
+// Difference returns the polygons covering the area inside this polygon but not the other.
+// If the other polygon is strictly inside this one the result is a polygon with a hole.
+// Results are closed and normalized, see Normalize. The polygons are not modified.
+// Holes are not supported, see Union for more details.
+func (p *Polygon) Difference(other *Polygon) ([]*Polygon, error) {
+	return polygonClip(p, other, clipDifference)
+}
+
+func polygonClip(subject, clip *Polygon, op clipOperation) ([]*Polygon, error) {
+	if len(subject.holes) != 0 || len(clip.holes) != 0 {
+		return nil, ErrClipHoles
+	}
+
+	s := clipRing(subject.exterior)
+	c := clipRing(clip.exterior)
+
+	if len(s) < 3 || len(c) < 3 {
+		var result []*Polygon
+		if len(s) >= 3 && op != clipIntersection {
+			result = append(result, clipPolygon(s))
+		}
+
+		if len(c) >= 3 && op == clipUnion {
+			result = append(result, clipPolygon(c))
+		}
+
+		return result, nil
+	}
+
+	bound := NewBoundFromPoints(&s[0], &s[0])
+	for i := range s {
+		bound.Extend(&s[i])
+	}
+	for i := range c {
+		bound.Extend(&c[i])
+	}
+	extent := math.Max(bound.Width(), bound.Height())
+
+	// move the clip polygon a tiny bit if there are degenerate intersections,
+	// in directions unlikely to line up with the edges
+	offsets := []Point{{0, 0}, {1, 0.6180339887}, {-0.6180339887, 1}, {-1, -0.6180339887}}
+
+	var sv, cv []*clipVertex
+	var found, degenerate bool
+	var offset Point
+	for _, o := range offsets {
+		offset = Point{o[0] * clipPerturbation * extent, o[1] * clipPerturbation * extent}
+
+		sv = clipList(s, Point{0, 0})
+		cv = clipList(c, offset)
+
+		found, degenerate = clipIntersections(sv, cv)
+		if !degenerate {
+			break
+		}
+	}
+
+	if degenerate {
+		return nil, ErrClipDegenerate
+	}
+
+	perturbed := offset != Point{0, 0}
+	clipPath := &Path{make(PointSet, len(cv))}
+	for i, v := range cv {
+		clipPath.PointSet[i] = v.point
+	}
+
+	if !found {
+		sInC, _ := clipPath.pointInPolygon(&s[0])
+		cInS, _ := (&Path{s}).pointInPolygon(&cv[0].point)
+
+		switch op {
+		case clipIntersection:
+			if sInC {
+				return []*Polygon{clipPolygon(s)}, nil
+			} else if cInS {
+				return []*Polygon{clipPolygon(c)}, nil
+			}
+			return nil, nil
+		case clipUnion:
+			if sInC {
+				return []*Polygon{clipPolygon(c)}, nil
+			} else if cInS {
+				return []*Polygon{clipPolygon(s)}, nil
+			}
+			return []*Polygon{clipPolygon(s), clipPolygon(c)}, nil
+		default:
+			if sInC {
+				return nil, nil
+			} else if cInS {
+				return []*Polygon{clipPolygon(s).AddHole(clipPolygon(c).Exterior()).Normalize()}, nil
+			}
+			return []*Polygon{clipPolygon(s)}, nil
+		}
+	}
+
+	// union goes around the outside of both, difference the outside of the subject
+	clipMarkEntries(sv[0], clipPath, op != clipIntersection)
+	clipMarkEntries(cv[0], &Path{s}, op == clipUnion)
+
+	rings := clipTrace(sv[0])
+
+	// snap back to the input vertices and remove the slivers
+	minArea := 0.0
+	if perturbed {
+		minArea = clipSliver * extent * extent
+		snap := clipSnap * extent
+
+		for _, ring := range rings {
+			for i := range ring {
+				ring[i] = clipSnapPoint(ring[i], s, c, snap)
+			}
+		}
+	}
+
+	var paths []*Path
+	for _, ring := range rings {
+		path := NewPath().SetPoints(ring).RemoveDuplicates()
+		if path.IsRing() {
+			path.Pop()
+		}
+
+		if len(path.PointSet) >= 3 && math.Abs(path.Area()) > minArea {
+			paths = append(paths, path.Close())
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	// overlapping polygons have a connected union, the largest ring is
+	// the exterior and the rest are holes
+	if op == clipUnion {
+		largest := 0
+		for i, path := range paths {
+			if math.Abs(path.Area()) > math.Abs(paths[largest].Area()) {
+				largest = i
+			}
+		}
+
+		polygon := NewPolygon(paths[largest])
+		for i, path := range paths {
+			if i != largest {
+				polygon.AddHole(path)
+			}
+		}
+
+		return []*Polygon{polygon.Normalize()}, nil
+	}
+
+	result := make([]*Polygon, 0, len(paths))
+	for _, path := range paths {
+		result = append(result, NewPolygon(path).Normalize())
+	}
+
+	return result, nil
+}
+
+// clipRing returns a copy of the ring points without consecutive duplicates
+// and without the closing point.
+func clipRing(ring *Path) PointSet {
+	path := ring.Clone().RemoveDuplicates()
+	if len(path.PointSet) > 1 && path.IsRing() {
+		path.Pop()
+	}
+
+	return path.PointSet
+}
+
+// clipPolygon returns a new closed and normalized polygon of the ring points.
+func clipPolygon(ring PointSet) *Polygon {
+	path := NewPath().SetPoints(append(PointSet(nil), ring...)).Close()
+	return NewPolygon(path).Normalize()
+}
+
+// clipList creates the circular doubly linked list of the ring, with the points offset.
+func clipList(ring PointSet, offset Point) []*clipVertex {
+	vertices := make([]*clipVertex, len(ring))
+	for i, p := range ring {
+		vertices[i] = &clipVertex{
+			point:    Point{p[0] + offset[0], p[1] + offset[1]},
+			original: p,
+		}
+	}
+
+	for i, v := range vertices {
+		v.next = vertices[(i+1)%len(vertices)]
+		v.next.prev = v
+	}
+
+	return vertices
+}
+
+// clipIntersections inserts the intersections of the edges into both lists.
+// Returns if any were found, or true for degenerate if the edges touch or overlap.
+func clipIntersections(s, c []*clipVertex) (found, degenerate bool) {
+	for i, s1 := range s {
+		s2 := s[(i+1)%len(s)]
+
+		for j, c1 := range c {
+			c2 := c[(j+1)%len(c)]
+
+			a, b, ok, degen := clipSegmentIntersection(&s1.point, &s2.point, &c1.point, &c2.point)
+			if degen {
+				return false, true
+			}
+
+			if !ok {
+				continue
+			}
+
+			p := Point{s1.point[0] + a*(s2.point[0]-s1.point[0]), s1.point[1] + a*(s2.point[1]-s1.point[1])}
+
+			is := &clipVertex{point: p, original: p, alpha: a, intersect: true}
+			ic := &clipVertex{point: p, original: p, alpha: b, intersect: true}
+			is.neighbor, ic.neighbor = ic, is
+
+			clipInsert(is, s1, s2)
+			clipInsert(ic, c1, c2)
+
+			found = true
+		}
+	}
+
+	return found, false
+}
+
+// clipSegmentIntersection returns where the segments cross as fractions along each,
+// or degenerate if they touch at an end point or overlap.
+func clipSegmentIntersection(s1, s2, c1, c2 *Point) (a, b float64, ok, degenerate bool) {
+	dsx, dsy := s2[0]-s1[0], s2[1]-s1[1]
+	dcx, dcy := c2[0]-c1[0], c2[1]-c1[1]
+	ex, ey := c1[0]-s1[0], c1[1]-s1[1]
+
+	d := dsx*dcy - dsy*dcx
+	if d == 0 {
+		if ex*dsy-ey*dsx != 0 {
+			return 0, 0, false, false // parallel
+		}
+
+		// collinear, degenerate if they overlap
+		l := dsx*dsx + dsy*dsy
+		t1 := (ex*dsx + ey*dsy) / l
+		t2 := ((c2[0]-s1[0])*dsx + (c2[1]-s1[1])*dsy) / l
+
+		return 0, 0, false, math.Max(t1, t2) >= 0 && math.Min(t1, t2) <= 1
+	}
+
+	a = (ex*dcy - ey*dcx) / d
+	b = (ex*dsy - ey*dsx) / d
+
+	if a < -clipEpsilon || a > 1+clipEpsilon || b < -clipEpsilon || b > 1+clipEpsilon {
+		return 0, 0, false, false
+	}
+
+	if a <= clipEpsilon || a >= 1-clipEpsilon || b <= clipEpsilon || b >= 1-clipEpsilon {
+		return 0, 0, false, true
+	}
+
+	return a, b, true, false
+}
+
+// clipInsert inserts the intersection vertex between start and end, sorted by alpha.
+func clipInsert(v, start, end *clipVertex) {
+	current := start
+	for current.next != end && current.next.alpha < v.alpha {
+		current = current.next
+	}
+
+	v.next = current.next
+	v.prev = current
+	current.next.prev = v
+	current.next = v
+}
+
+// clipMarkEntries sets if each intersection enters the other polygon, or exits if inverted.
+func clipMarkEntries(first *clipVertex, other *Path, invert bool) {
+	inside, _ := other.pointInPolygon(&first.point)
+
+	v := first
+	for {
+		if v.intersect {
+			v.entry = inside == invert
+			inside = !inside
+		}
+
+		v = v.next
+		if v == first {
+			return
+		}
+	}
+}
+
+// clipTrace returns the result rings, following the lists forward from
+// entry intersections and backwards from exits, switching lists at each one.
+func clipTrace(first *clipVertex) []PointSet {
+	var rings []PointSet
+
+	v := first
+	for {
+		if v.intersect && !v.visited {
+			var ring PointSet
+
+			current := v
+			for !current.visited {
+				current.visited = true
+				current.neighbor.visited = true
+				ring = append(ring, current.original)
+
+				forward := current.entry
+				for {
+					if forward {
+						current = current.next
+					} else {
+						current = current.prev
+					}
+
+					if current.intersect {
+						break
+					}
+					ring = append(ring, current.original)
+				}
+
+				current = current.neighbor
+			}
+
+			rings = append(rings, ring)
+		}
+
+		v = v.next
+		if v == first {
+			return rings
+		}
+	}
+}
+
+// clipSnapPoint returns the input vertex within the distance of the point, or the point.
+func clipSnapPoint(p Point, s, c PointSet, distance float64) Point {
+	d2 := distance * distance
+	for _, ring := range []PointSet{s, c} {
+		for i := range ring {
+			if ring[i].SquaredDistanceFrom(&p) <= d2 {
+				return ring[i]
+			}
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,305 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func testRectangle(west, east, south, north float64) *Polygon {
+	return NewPolygon(NewPathFromXYData([][2]float64{
+		{west, south}, {east, south}, {east, north}, {west, north}, {west, south},
+	}))
+}
+
+// testLShape is a 2x2 square with the top right 1x1 square removed, area 3.
+func testLShape() *Polygon {
+	return NewPolygon(NewPathFromXYData([][2]float64{
+		{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}, {0, 0},
+	}))
+}
+
+// clipMust returns a function that fails the test if the operation errors.
+func clipMust(t *testing.T) func([]*Polygon, error) []*Polygon {
+	return func(polygons []*Polygon, err error) []*Polygon {
+		if err != nil {
+			t.Fatalf("polygon, unexpected error: %v", err)
+		}
+
+		return polygons
+	}
+}
+
+func polygonsArea(polygons []*Polygon) float64 {
+	area := 0.0
+	for _, p := range polygons {
+		area += p.Area()
+	}
+
+	return area
+}
+
+func TestPolygonBooleanOperations(t *testing.T) {
+	must := clipMust(t)
+
+	cases := []struct {
+		name         string
+		p1, p2       *Polygon
+		intersection float64
+		union        float64
+		difference   float64
+	}{
+		{
+			name:         "overlapping rectangles",
+			p1:           testRectangle(0, 2, 0, 2),
+			p2:           testRectangle(1, 3, 1, 3),
+			intersection: 1,
+			union:        7,
+			difference:   3,
+		},
+		{
+			name:         "crossing rectangles",
+			p1:           testRectangle(0, 4, 1, 2),
+			p2:           testRectangle(1, 2, 0, 3),
+			intersection: 1,
+			union:        6,
+			difference:   3,
+		},
+		{
+			name:         "L shape and square",
+			p1:           testLShape(),
+			p2:           testRectangle(0.5, 1.5, 0.5, 1.5),
+			intersection: 0.75,
+			union:        3.25,
+			difference:   2.25,
+		},
+		{
+			name:         "L shape across the notch",
+			p1:           testLShape(),
+			p2:           testRectangle(0.5, 2.5, 1.5, 2.5),
+			intersection: 0.25,
+			union:        4.75,
+			difference:   2.75,
+		},
+		{
+			name:         "contained",
+			p1:           testRectangle(0, 4, 0, 4),
+			p2:           testRectangle(1, 2, 1, 2),
+			intersection: 1,
+			union:        16,
+			difference:   15,
+		},
+		{
+			name:         "disjoint",
+			p1:           testRectangle(0, 1, 0, 1),
+			p2:           testRectangle(2, 3, 0, 1),
+			intersection: 0,
+			union:        2,
+			difference:   1,
+		},
+		{
+			name:         "identical",
+			p1:           testRectangle(0, 2, 0, 2),
+			p2:           testRectangle(0, 2, 0, 2),
+			intersection: 4,
+			union:        4,
+			difference:   0,
+		},
+		{
+			name:         "shared edge",
+			p1:           testRectangle(0, 1, 0, 1),
+			p2:           testRectangle(1, 2, 0, 1),
+			intersection: 0,
+			union:        2,
+			difference:   1,
+		},
+		{
+			name:         "overlapping with shared edges",
+			p1:           testRectangle(0, 2, 0, 2),
+			p2:           testRectangle(1, 3, 0, 2),
+			intersection: 2,
+			union:        6,
+			difference:   2,
+		},
+		{
+			name:         "L shape and its notch",
+			p1:           testLShape(),
+			p2:           testRectangle(1, 2, 1, 2),
+			intersection: 0,
+			union:        4,
+			difference:   3,
+		},
+	}
+
+	for _, tc := range cases {
+		original := tc.p1.Clone()
+
+		if a := polygonsArea(must(tc.p1.Intersection(tc.p2))); math.Abs(a-tc.intersection) > 1e-6 {
+			t.Errorf("polygon, %s intersection area expected %v, got %v", tc.name, tc.intersection, a)
+		}
+
+		if a := polygonsArea(must(tc.p2.Intersection(tc.p1))); math.Abs(a-tc.intersection) > 1e-6 {
+			t.Errorf("polygon, %s reversed intersection area expected %v, got %v", tc.name, tc.intersection, a)
+		}
+
+		if a := polygonsArea(must(tc.p1.Union(tc.p2))); math.Abs(a-tc.union) > 1e-6 {
+			t.Errorf("polygon, %s union area expected %v, got %v", tc.name, tc.union, a)
+		}
+
+		if a := polygonsArea(must(tc.p2.Union(tc.p1))); math.Abs(a-tc.union) > 1e-6 {
+			t.Errorf("polygon, %s reversed union area expected %v, got %v", tc.name, tc.union, a)
+		}
+
+		if a := polygonsArea(must(tc.p1.Difference(tc.p2))); math.Abs(a-tc.difference) > 1e-6 {
+			t.Errorf("polygon, %s difference area expected %v, got %v", tc.name, tc.difference, a)
+		}
+
+		// results are valid and normalized
+		for _, results := range [][]*Polygon{must(tc.p1.Intersection(tc.p2)), must(tc.p1.Union(tc.p2)), must(tc.p1.Difference(tc.p2))} {
+			for _, r := range results {
+				if !r.IsNormalized() || !r.Exterior().IsRing() {
+					t.Errorf("polygon, %s result should be closed and normalized, got %v", tc.name, r)
+				}
+			}
+		}
+
+		if !tc.p1.Equals(original) {
+			t.Errorf("polygon, %s should not modify the polygon", tc.name)
+		}
+	}
+}
+
+func TestPolygonIntersectionShape(t *testing.T) {
+	must := clipMust(t)
+
+	result := must(testRectangle(0, 2, 0, 2).Intersection(testRectangle(1, 3, 1, 3)))
+	if len(result) != 1 {
+		t.Fatalf("polygon, expected one polygon, got %v", result)
+	}
+
+	if !result[0].Exterior().ApproxEquals(testRectangle(1, 2, 1, 2).Exterior(), 0) {
+		// same points, maybe from a different start
+		if b := result[0].Bound(); !b.Equals(NewBound(1, 2, 1, 2)) || result[0].Exterior().Length() != 5 {
+			t.Errorf("polygon, intersection expected unit square, got %v", result[0])
+		}
+	}
+
+	// identical polygons snap back to the input vertices
+	result = must(testLShape().Intersection(testLShape()))
+	if len(result) != 1 || result[0].Bound().Equals(NewBound(0, 2, 0, 2)) == false {
+		t.Fatalf("polygon, intersection with itself should be itself, got %v", result)
+	}
+
+	for _, p := range result[0].Exterior().PointSet {
+		if p[0] != math.Floor(p[0]) || p[1] != math.Floor(p[1]) {
+			t.Errorf("polygon, intersection with itself should snap to vertices, got %v", p)
+		}
+	}
+}
+
+func TestPolygonIntersectionPieces(t *testing.T) {
+	must := clipMust(t)
+
+	// a U shape and a bar across both arms
+	u := NewPolygon(NewPathFromXYData([][2]float64{
+		{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}, {0, 0},
+	}))
+	bar := testRectangle(-1, 4, 1.5, 2.5)
+
+	result := must(u.Intersection(bar))
+	if len(result) != 2 {
+		t.Errorf("polygon, expected 2 pieces, got %d", len(result))
+	}
+
+	for _, r := range result {
+		if math.Abs(r.Area()-1) > 1e-9 {
+			t.Errorf("polygon, pieces should have area 1, got %v", r.Area())
+		}
+	}
+
+	// the bar closes the U, the union has a hole
+	result = must(u.Union(bar))
+	if len(result) != 1 || len(result[0].Holes()) != 1 {
+		t.Fatalf("polygon, union should be one polygon with a hole, got %v", result)
+	}
+
+	if a := result[0].Area(); math.Abs(a-10) > 1e-9 {
+		t.Errorf("polygon, union area expected 10, got %v", a)
+	}
+
+	if result[0].Contains(NewPoint(1.5, 1.25)) || !result[0].Contains(NewPoint(1.5, 2)) {
+		t.Errorf("polygon, union hole incorrect")
+	}
+
+	// the bar cuts the U arms off
+	result = must(u.Difference(bar))
+	if len(result) != 3 || math.Abs(polygonsArea(result)-5) > 1e-9 {
+		t.Errorf("polygon, difference expected 3 pieces with area 5, got %v", result)
+	}
+}
+
+func TestPolygonDifferenceHole(t *testing.T) {
+	must := clipMust(t)
+
+	result := must(testRectangle(0, 4, 0, 4).Difference(testRectangle(1, 2, 1, 2)))
+	if len(result) != 1 || len(result[0].Holes()) != 1 {
+		t.Fatalf("polygon, difference should have a hole, got %v", result)
+	}
+
+	if result[0].Contains(NewPoint(1.5, 1.5)) || !result[0].Contains(NewPoint(3, 3)) {
+		t.Errorf("polygon, difference hole incorrect")
+	}
+
+	if !result[0].IsValid() {
+		t.Errorf("polygon, difference should be valid, got %v", result[0])
+	}
+}
+
+func TestPolygonBooleanOperationsEmpty(t *testing.T) {
+	must := clipMust(t)
+
+	square := testRectangle(0, 1, 0, 1)
+	empty := NewPolygon(NewPath())
+
+	if r := must(square.Intersection(empty)); len(r) != 0 {
+		t.Errorf("polygon, intersection with empty should be empty, got %v", r)
+	}
+
+	if r := must(empty.Union(square)); len(r) != 1 || r[0].Area() != 1 {
+		t.Errorf("polygon, union with empty should be the polygon, got %v", r)
+	}
+
+	if r := must(square.Difference(empty)); len(r) != 1 || r[0].Area() != 1 {
+		t.Errorf("polygon, difference with empty should be the polygon, got %v", r)
+	}
+
+	if r := must(empty.Difference(square)); len(r) != 0 {
+		t.Errorf("polygon, difference of empty should be empty, got %v", r)
+	}
+}
+
+func TestPolygonBooleanOperationsHoles(t *testing.T) {
+	must := clipMust(t)
+
+	// the result of a difference can have a hole
+	square := testRectangle(0, 10, 0, 10)
+	result := must(square.Difference(testRectangle(3, 6, 3, 6)))
+	if len(result) != 1 || len(result[0].Holes()) != 1 {
+		t.Fatalf("polygon, difference should have a hole, got %v", result)
+	}
+
+	operations := []func(p, other *Polygon) ([]*Polygon, error){
+		(*Polygon).Intersection,
+		(*Polygon).Union,
+		(*Polygon).Difference,
+	}
+
+	for i, op := range operations {
+		if r, err := op(result[0], square); err != ErrClipHoles || r != nil {
+			t.Errorf("polygon, operation %d with holes should error, got %v %v", i, r, err)
+		}
+
+		if r, err := op(square, testPark()); err != ErrClipHoles || r != nil {
+			t.Errorf("polygon, operation %d with holes in other should error, got %v %v", i, r, err)
+		}
+	}
+}
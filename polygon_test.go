@@ -0,0 +1,363 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// testPark is a 10x10 square, counter clockwise, with a 2x2 clockwise lake hole.
+func testPark() *Polygon {
+	exterior := NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}})
+	lake := NewPathFromXYData([][2]float64{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}})
+
+	return NewPolygon(exterior).AddHole(lake)
+}
+
+func TestNewPolygon(t *testing.T) {
+	exterior := NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}})
+	p := NewPolygon(exterior)
+
+	if p.Exterior() != exterior || len(p.Holes()) != 0 {
+		t.Errorf("polygon, should have exterior and no holes")
+	}
+
+	hole := NewPath()
+	p.AddHole(hole)
+	if len(p.Holes()) != 1 || p.Holes()[0] != hole {
+		t.Errorf("polygon, should have added hole")
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	park := testPark()
+
+	cases := []struct {
+		name     string
+		point    *Point
+		expected bool
+	}{
+		{"inside", NewPoint(5, 5), true},
+		{"near edge", NewPoint(9.9, 0.1), true},
+		{"in lake", NewPoint(3, 3), false},
+		{"lake edge", NewPoint(2, 3), false},
+		{"lake corner", NewPoint(4, 4), false},
+		{"exterior edge", NewPoint(10, 5), false},
+		{"exterior corner", NewPoint(0, 0), false},
+		{"outside", NewPoint(11, 5), false},
+	}
+
+	for _, tc := range cases {
+		if v := park.Contains(tc.point); v != tc.expected {
+			t.Errorf("polygon, contains %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+	}
+
+	// an island in the lake is inside with even-odd
+	island := NewPathFromXYData([][2]float64{{2.5, 2.5}, {3.5, 2.5}, {3.5, 3.5}, {2.5, 3.5}, {2.5, 2.5}})
+	park.AddHole(island)
+
+	if !park.Contains(NewPoint(3, 3)) {
+		t.Errorf("polygon, island in lake should be inside")
+	}
+}
+
+func TestPolygonContainsWinding(t *testing.T) {
+	// a ring looping around twice on the right side
+	ring := NewPathFromXYData([][2]float64{
+		{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0},
+		{5, 0}, {10, 0}, {10, 5}, {5, 5}, {5, 0}, {0, 0},
+	})
+
+	p := NewPolygon(ring)
+	if !p.Contains(NewPoint(7, 2)) || !p.Contains(NewPoint(2, 7)) {
+		t.Errorf("polygon, overlapping loops should be inside")
+	}
+
+	if ring.Contains(NewPoint(7, 2)) {
+		t.Errorf("polygon, path contains should use even-odd")
+	}
+
+	// holes work for either winding
+	for _, hole := range []*Path{
+		NewPathFromXYData([][2]float64{{1, 6}, {1, 8}, {3, 8}, {3, 6}, {1, 6}}),
+		NewPathFromXYData([][2]float64{{1, 6}, {3, 6}, {3, 8}, {1, 8}, {1, 6}}),
+	} {
+		p := NewPolygon(ring, hole)
+		if p.Contains(NewPoint(2, 7)) || !p.Contains(NewPoint(4, 7)) {
+			t.Errorf("polygon, hole incorrect for %v", hole)
+		}
+	}
+}
+
+func TestPolygonArea(t *testing.T) {
+	park := testPark()
+	if a := park.Area(); a != 96 {
+		t.Errorf("polygon, area expected 96, got %f", a)
+	}
+
+	// same for any winding
+	park = NewPolygon(park.Exterior().Reverse()).AddHole(park.Holes()[0].Reverse())
+	if a := park.Area(); a != 96 {
+		t.Errorf("polygon, reversed area expected 96, got %f", a)
+	}
+
+	if a := NewPolygon(NewPath()).Area(); a != 0 {
+		t.Errorf("polygon, empty area expected 0, got %f", a)
+	}
+}
+
+func TestPolygonGeoArea(t *testing.T) {
+	exterior := NewPathFromXYData([][2]float64{{0, 0}, {0.2, 0}, {0.2, 0.2}, {0, 0.2}, {0, 0}})
+	hole := NewPathFromXYData([][2]float64{{0.05, 0.05}, {0.15, 0.05}, {0.15, 0.15}, {0.05, 0.15}, {0.05, 0.05}})
+
+	p := NewPolygon(exterior, hole)
+
+	expected := math.Abs(exterior.GeoArea()) * 3 / 4
+	if a := p.GeoArea(); math.Abs(a-expected)/expected > 1e-3 {
+		t.Errorf("polygon, geo area expected %f, got %f", expected, a)
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	// hole in the bottom left moves the centroid up and right
+	p := NewPolygon(
+		NewPathFromXYData([][2]float64{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}),
+		NewPathFromXYData([][2]float64{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {0, 0}}),
+	)
+
+	// (16*(2,2) - 4*(1,1)) / 12
+	expected := NewPoint(7.0/3.0, 7.0/3.0)
+	if c := p.Centroid(); c.DistanceFrom(expected) > epsilon {
+		t.Errorf("polygon, centroid expected %v, got %v", expected, c)
+	}
+
+	if c := testPark().Centroid(); c.DistanceFrom(NewPoint(5.0833333, 5.0833333)) > epsilon {
+		t.Errorf("polygon, park centroid incorrect, got %v", c)
+	}
+
+	// no holes, same as path
+	p = NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}))
+	if c := p.Centroid(); !c.Equals(p.Exterior().Centroid()) {
+		t.Errorf("polygon, centroid without holes should match path, got %v", c)
+	}
+}
+
+func TestPolygonBound(t *testing.T) {
+	if b := testPark().Bound(); !b.Equals(NewBound(0, 10, 0, 10)) {
+		t.Errorf("polygon, bound incorrect, got %v", b)
+	}
+}
+
+func TestPolygonIsValid(t *testing.T) {
+	exterior := func() *Path {
+		return NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}})
+	}
+
+	cases := []struct {
+		name     string
+		polygon  *Polygon
+		expected bool
+	}{
+		{"park", testPark(), true},
+		{"no holes", NewPolygon(exterior()), true},
+		{"exterior not closed", NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}})), false},
+		{"exterior too short", NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {0, 0}})), false},
+		{"empty", NewPolygon(NewPath()), false},
+		{
+			name:     "hole not closed",
+			polygon:  NewPolygon(exterior(), NewPathFromXYData([][2]float64{{2, 2}, {2, 4}, {4, 4}, {4, 2}})),
+			expected: false,
+		},
+		{
+			name:     "hole outside",
+			polygon:  NewPolygon(exterior(), NewPathFromXYData([][2]float64{{12, 2}, {12, 4}, {14, 4}, {12, 2}})),
+			expected: false,
+		},
+		{
+			name:     "hole crossing",
+			polygon:  NewPolygon(exterior(), NewPathFromXYData([][2]float64{{8, 2}, {8, 4}, {12, 4}, {8, 2}})),
+			expected: false,
+		},
+		{
+			name:     "hole touching",
+			polygon:  NewPolygon(exterior(), NewPathFromXYData([][2]float64{{0, 2}, {2, 4}, {2, 2}, {0, 2}})),
+			expected: false,
+		},
+		{
+			name:     "hole around exterior",
+			polygon:  NewPolygon(exterior(), NewPathFromXYData([][2]float64{{-1, -1}, {11, -1}, {11, 11}, {-1, -1}})),
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if v := tc.polygon.IsValid(); v != tc.expected {
+			t.Errorf("polygon, is valid %s expected %v, got %v", tc.name, tc.expected, v)
+		}
+	}
+}
+
+func TestPolygonEquals(t *testing.T) {
+	p := testPark()
+	c := p.Clone()
+
+	if !p.Equals(c) {
+		t.Errorf("polygon, clone should be equal")
+	}
+
+	c.Holes()[0].SetAt(1, NewPoint(2, 5))
+	if p.Equals(c) {
+		t.Errorf("polygon, clone should be a deep copy")
+	}
+
+	if p.Equals(NewPolygon(p.Exterior())) {
+		t.Errorf("polygon, different number of holes should not be equal")
+	}
+}
+
+func TestPolygonToWKT(t *testing.T) {
+	expected := "POLYGON((0 0,10 0,10 10,0 10,0 0),(2 2,2 4,4 4,4 2,2 2))"
+	if s := testPark().ToWKT(); s != expected {
+		t.Errorf("polygon, wkt expected %s, got %s", expected, s)
+	}
+
+	if s := testPark().String(); s != expected {
+		t.Errorf("polygon, string expected %s, got %s", expected, s)
+	}
+
+	// rings are closed
+	p := NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {1, 1.5}}))
+	if s := p.ToWKT(); s != "POLYGON((0 0,1 0,1 1.5,0 0))" {
+		t.Errorf("polygon, wkt should close ring, got %s", s)
+	}
+
+	if len(p.Exterior().PointSet) != 3 {
+		t.Errorf("polygon, wkt should not modify the ring")
+	}
+
+	if s := NewPolygon(NewPath()).ToWKT(); s != "POLYGON EMPTY" {
+		t.Errorf("polygon, empty wkt incorrect, got %s", s)
+	}
+}
+
+func TestPolygonToGeoJSON(t *testing.T) {
+	f := testPark().ToGeoJSON()
+
+	if !f.Geometry.IsPolygon() {
+		t.Fatalf("polygon, geojson should be a polygon, got %v", f.Geometry.Type)
+	}
+
+	expected := [][][]float64{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+	}
+
+	if !reflect.DeepEqual(f.Geometry.Polygon, expected) {
+		t.Errorf("polygon, geojson coordinates incorrect, got %v", f.Geometry.Polygon)
+	}
+
+	// rings are closed
+	g := NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {1, 0}, {1, 1}})).ToGeoJSONGeometry()
+	if !reflect.DeepEqual(g.Polygon, [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}) {
+		t.Errorf("polygon, geojson should close ring, got %v", g.Polygon)
+	}
+}
+
+func TestPolygonNormalize(t *testing.T) {
+	// clockwise exterior and counter clockwise hole, as from a shapefile
+	p := NewPolygon(
+		NewPathFromXYData([][2]float64{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}),
+		NewPathFromXYData([][2]float64{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}),
+	)
+
+	if p.IsNormalized() {
+		t.Errorf("polygon, should not be normalized")
+	}
+
+	points := []*Point{NewPoint(5, 5), NewPoint(3, 3), NewPoint(2, 3), NewPoint(11, 5)}
+	contains := make([]bool, len(points))
+	for i, point := range points {
+		contains[i] = p.Contains(point)
+	}
+
+	p.Normalize()
+	if !p.IsNormalized() {
+		t.Errorf("polygon, should be normalized")
+	}
+
+	if p.Exterior().IsClockwise() || !p.Holes()[0].IsClockwise() {
+		t.Errorf("polygon, both rings should be flipped, got %v", p)
+	}
+
+	expected := NewPathFromXYData([][2]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}})
+	if !p.Exterior().Equals(expected) {
+		t.Errorf("polygon, exterior should be reversed, got %v", p.Exterior())
+	}
+
+	for i, point := range points {
+		if v := p.Contains(point); v != contains[i] {
+			t.Errorf("polygon, contains %v should not change, got %v", point, v)
+		}
+	}
+
+	// already normalized are not changed
+	park := testPark()
+	if !park.IsNormalized() || !park.Clone().Normalize().Equals(park) {
+		t.Errorf("polygon, normalized polygon should not change")
+	}
+
+	// only the wrong ring is flipped
+	p = NewPolygon(testPark().Exterior()).AddHole(testPark().Holes()[0].Reverse())
+	if p.IsNormalized() || !p.Normalize().Equals(testPark()) {
+		t.Errorf("polygon, only the hole should be flipped, got %v", p)
+	}
+
+	// degenerate rings are not changed
+	p = NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {1, 1}, {0, 0}}))
+	if !p.IsNormalized() || !p.Normalize().Exterior().Equals(NewPathFromXYData([][2]float64{{0, 0}, {1, 1}, {0, 0}})) {
+		t.Errorf("polygon, degenerate ring should not change, got %v", p)
+	}
+}
+
+func TestPolygonToGeoJSONWinding(t *testing.T) {
+	p := NewPolygon(
+		NewPathFromXYData([][2]float64{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}),
+		NewPathFromXYData([][2]float64{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}),
+	)
+
+	// normalized by default
+	expected := [][][]float64{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+	}
+
+	if g := p.ToGeoJSON().Geometry; !reflect.DeepEqual(g.Polygon, expected) {
+		t.Errorf("polygon, geojson should be normalized, got %v", g.Polygon)
+	}
+
+	if p.IsNormalized() {
+		t.Errorf("polygon, geojson should not modify the polygon")
+	}
+
+	// unless the winding is preserved
+	expected = [][][]float64{
+		{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+		{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}},
+	}
+
+	g := p.ToGeoJSONGeometryWithOptions(GeoJSONOptions{PreserveWinding: true})
+	if !reflect.DeepEqual(g.Polygon, expected) {
+		t.Errorf("polygon, geojson should preserve winding, got %v", g.Polygon)
+	}
+
+	// unclosed rings are closed and reversed
+	p = NewPolygon(NewPathFromXYData([][2]float64{{0, 0}, {0, 1}, {1, 1}}))
+	if g := p.ToGeoJSONGeometry(); !reflect.DeepEqual(g.Polygon, [][][]float64{{{0, 0}, {1, 1}, {0, 1}, {0, 0}}}) {
+		t.Errorf("polygon, geojson should close and reverse ring, got %v", g.Polygon)
+	}
+
+	if g := NewPolygon(NewPath(), NewPath()).ToGeoJSONGeometry(); len(g.Polygon) != 0 {
+		t.Errorf("polygon, geojson of empty polygon should have no rings, got %v", g.Polygon)
+	}
+}
@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RandomPoint returns a point uniformly distributed within the bound.
+// A nil rng uses the default source of the math/rand package.
+// Assumes euclidean geometry, use GeoRandomPoint for lng/lat bounds.
+func (b *Bound) RandomPoint(rng *rand.Rand) *Point {
+	return &Point{
+		b.sw[0] + randFloat64(rng)*(b.ne[0]-b.sw[0]),
+		b.sw[1] + randFloat64(rng)*(b.ne[1]-b.sw[1]),
+	}
+}
+
+// GeoRandomPoint returns a lng/lat point uniformly distributed, by area on the sphere,
+// within the bound. Latitudes are weighted by their cosine so points are less
+// dense towards the poles than with RandomPoint.
+// A nil rng uses the default source of the math/rand package.
+func (b *Bound) GeoRandomPoint(rng *rand.Rand) *Point {
+	// uniform in sin(lat) is uniform in area
+	s := math.Sin(deg2rad(b.sw[1]))
+	n := math.Sin(deg2rad(b.ne[1]))
+
+	return &Point{
+		b.sw[0] + randFloat64(rng)*(b.ne[0]-b.sw[0]),
+		rad2deg(math.Asin(s + randFloat64(rng)*(n-s))),
+	}
+}
+
+// RandomPoints returns n points uniformly distributed within the bound, see RandomPoint.
+func (b *Bound) RandomPoints(n int, rng *rand.Rand) *PointSet {
+	ps := NewPointSetPreallocate(0, n)
+	for i := 0; i < n; i++ {
+		ps.Push(b.RandomPoint(rng))
+	}
+
+	return ps
+}
+
+// GeoRandomPoints returns n lng/lat points uniformly distributed within the bound,
+// see GeoRandomPoint.
+func (b *Bound) GeoRandomPoints(n int, rng *rand.Rand) *PointSet {
+	ps := NewPointSetPreallocate(0, n)
+	for i := 0; i < n; i++ {
+		ps.Push(b.GeoRandomPoint(rng))
+	}
+
+	return ps
+}
+
+// RandomPointAlong returns a point uniformly distributed by length along the path.
+// Returns nil for empty paths. A nil rng uses the default source of the math/rand package.
+// Assumes euclidean geometry, use GeoRandomPointAlong for lng/lat paths.
+func (p *Path) RandomPointAlong(rng *rand.Rand) *Point {
+	return p.PointAtDistance(randFloat64(rng) * p.Distance())
+}
+
+// GeoRandomPointAlong returns a point uniformly distributed by length along the
+// lng/lat path, with segments measured using spherical geometry.
+// Returns nil for empty paths. A nil rng uses the default source of the math/rand package.
+func (p *Path) GeoRandomPointAlong(rng *rand.Rand) *Point {
+	return p.GeoPointAtDistance(randFloat64(rng) * p.GeoDistance())
+}
+
+// randFloat64 returns a number in [0, 1) from the source, or the package default if nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+
+	return rng.Float64()
+}
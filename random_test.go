@@ -0,0 +1,170 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBoundRandomPoint(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bound := NewBound(-1, 3, 10, 12)
+
+	ps := bound.RandomPoints(1000, r)
+	if ps.Length() != 1000 {
+		t.Fatalf("random, expected 1000 points, got %d", ps.Length())
+	}
+
+	// halves should have about the same number of points
+	below := 0
+	for _, p := range *ps {
+		if !bound.Contains(&p) {
+			t.Fatalf("random, point should be within bound, got %v", p)
+		}
+
+		if p[1] < 11 {
+			below++
+		}
+	}
+
+	if below < 450 || below > 550 {
+		t.Errorf("random, expected about half below the middle, got %d", below)
+	}
+
+	// nil uses the default source
+	if p := bound.RandomPoint(nil); !bound.Contains(p) {
+		t.Errorf("random, point from default source should be within bound, got %v", p)
+	}
+
+	// same seed, same points
+	p1 := bound.RandomPoint(rand.New(rand.NewSource(1)))
+	p2 := bound.RandomPoint(rand.New(rand.NewSource(1)))
+	if !p1.Equals(p2) {
+		t.Errorf("random, same seed should give same point, %v != %v", p1, p2)
+	}
+}
+
+func TestBoundGeoRandomPoint(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bound := NewBound(0, 10, 0, 80)
+
+	ps := bound.GeoRandomPoints(20000, r)
+	for _, p := range *ps {
+		if !bound.Contains(&p) {
+			t.Fatalf("random, point should be within bound, got %v", p)
+		}
+	}
+
+	// chi-squared over 10 degree latitude bands, with 7 degrees of freedom
+	// the critical value at p = 0.001 is 24.32
+	area := func(south, north float64) float64 {
+		return math.Sin(deg2rad(north)) - math.Sin(deg2rad(south))
+	}
+
+	total := area(0, 80)
+	cosWeighted, uniform := randomChiSquared(ps, 8, 10, func(south, north float64) float64 {
+		return area(south, north) / total
+	})
+
+	if cosWeighted > 24.32 {
+		t.Errorf("random, latitudes should be cos weighted, chi-squared %f", cosWeighted)
+	}
+
+	if uniform < 24.32 {
+		t.Errorf("random, latitudes should not be uniform in degrees, chi-squared %f", uniform)
+	}
+
+	// degree uniform points fail the cos weighted test
+	cosWeighted, uniform = randomChiSquared(bound.RandomPoints(20000, r), 8, 10, func(south, north float64) float64 {
+		return area(south, north) / total
+	})
+
+	if cosWeighted < 24.32 || uniform > 24.32 {
+		t.Errorf("random, euclidean points should be uniform in degrees, chi-squared %f, %f", cosWeighted, uniform)
+	}
+}
+
+// randomChiSquared returns the chi-squared statistic of the point latitudes
+// in the bands against the expected fractions, and against a uniform distribution.
+func randomChiSquared(ps *PointSet, bands int, size float64, fraction func(south, north float64) float64) (float64, float64) {
+	counts := make([]float64, bands)
+	for _, p := range *ps {
+		counts[int(p[1]/size)]++
+	}
+
+	n := float64(ps.Length())
+	expected, uniform := 0.0, 0.0
+	for i, c := range counts {
+		e := n * fraction(float64(i)*size, float64(i+1)*size)
+		expected += (c - e) * (c - e) / e
+
+		u := n / float64(bands)
+		uniform += (c - u) * (c - u) / u
+	}
+
+	return expected, uniform
+}
+
+func TestPathRandomPointAlong(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	// first segment is a quarter of the length
+	p := NewPath().
+		Push(NewPoint(0, 0)).
+		Push(NewPoint(1, 0)).
+		Push(NewPoint(1, 3))
+
+	first := 0
+	for i := 0; i < 4000; i++ {
+		point := p.RandomPointAlong(r)
+		if p.DistanceFrom(point) > epsilon {
+			t.Fatalf("random, point should be on path, got %v", point)
+		}
+
+		if point[1] == 0 {
+			first++
+		}
+	}
+
+	if first < 900 || first > 1100 {
+		t.Errorf("random, expected about a quarter on the first segment, got %d", first)
+	}
+
+	if point := NewPath().RandomPointAlong(r); point != nil {
+		t.Errorf("random, empty path should return nil, got %v", point)
+	}
+
+	if point := NewPath().Push(NewPoint(1, 2)).RandomPointAlong(nil); !point.Equals(NewPoint(1, 2)) {
+		t.Errorf("random, single point path should return the point, got %v", point)
+	}
+}
+
+func TestPathGeoRandomPointAlong(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	// at 60 degrees 2 degrees of longitude is about as long as 1 of latitude
+	p := NewPath().
+		Push(NewPoint(0, 60)).
+		Push(NewPoint(2, 60)).
+		Push(NewPoint(2, 61))
+
+	first := 0
+	for i := 0; i < 4000; i++ {
+		point := p.GeoRandomPointAlong(r)
+		if p.DistanceFrom(point) > epsilon {
+			t.Fatalf("random, point should be on path, got %v", point)
+		}
+
+		if point[1] == 60 {
+			first++
+		}
+	}
+
+	if first < 1900 || first > 2100 {
+		t.Errorf("random, expected about half on the first segment, got %d", first)
+	}
+
+	if point := NewPath().GeoRandomPointAlong(nil); point != nil {
+		t.Errorf("random, empty path should return nil, got %v", point)
+	}
+}
@@ -0,0 +1,39 @@
+package reducers
+
+import "testing"
+
+func TestNthPointBenchmarkData(t *testing.T) {
+	path := benchmarkData()
+
+	for _, n := range []int{1, 2, 5, 10} {
+		p := NthPoint(path, n)
+		expected := (path.Length()-1)/n + 1
+		if (path.Length()-1)%n != 0 {
+			expected++
+		}
+
+		if p.Length() != expected {
+			t.Errorf("nth point benchmark data reduced poorly, got %d, expected %d", p.Length(), expected)
+		}
+	}
+}
+
+func BenchmarkNthPoint(b *testing.B) {
+	path := benchmarkData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NthPoint(path, 5)
+	}
+}
+
+func BenchmarkNthPointMillion(b *testing.B) {
+	path := millionPointData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NthPoint(path, 5)
+	}
+}
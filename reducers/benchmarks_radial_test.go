@@ -1,6 +1,10 @@
 package reducers
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/paulmach/go.geo"
+)
 
 func TestRadialBenchmarkData(t *testing.T) {
 	type reduceTest struct {
@@ -46,3 +50,39 @@ func BenchmarkRadialIndexMap(b *testing.B) {
 		RadialIndexMap(path, 0.1)
 	}
 }
+
+func BenchmarkRadialMillion(b *testing.B) {
+	path := millionPointData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Radial(path, 0.1)
+	}
+}
+
+func BenchmarkRadialGeoMillion(b *testing.B) {
+	path := millionPointData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		RadialGeo(path, 10)
+	}
+}
+
+// millionPointData returns a lng/lat track of a million points
+// about a meter apart, wandering around San Francisco.
+func millionPointData() *geo.Path {
+	path := geo.NewPathPreallocate(0, 1000000)
+
+	lng, lat := -122.4167, 37.7833
+	for i := 0; i < 1000000; i++ {
+		path.Push(geo.NewPoint(lng, lat))
+
+		lng += 1e-5 * float64(i%7-3) / 3
+		lat += 1e-5 * float64(i%5-2) / 2
+	}
+
+	return path
+}
@@ -3,6 +3,8 @@ package reducers
 import (
 	"reflect"
 	"testing"
+
+	"github.com/paulmach/go.geo"
 )
 
 func TestMergeIndexMaps(t *testing.T) {
@@ -25,5 +27,43 @@ func TestMergeIndexMaps(t *testing.T) {
 	if !reflect.DeepEqual(merged, []int{0, 3, 7}) {
 		t.Errorf("mergeIndexMaps result incorrect, got %v", merged)
 	}
+}
+
+func TestIndexMapsBenchmarkData(t *testing.T) {
+	path := benchmarkData()
+
+	reduced, im := DouglasPeuckerIndexMap(path, 0.5)
+	checkIndexMap(t, "douglas peucker", path, reduced, im)
+
+	reduced, im = VisvalingamIndexMap(path, 0.5, 0)
+	checkIndexMap(t, "visvalingam", path, reduced, im)
+
+	reduced, im = RadialIndexMap(path, 0.5)
+	checkIndexMap(t, "radial", path, reduced, im)
+
+	reduced, im = NthPointIndexMap(path, 5)
+	checkIndexMap(t, "nth point", path, reduced, im)
 
+	// chained reducers
+	p1, im1 := RadialIndexMap(path, 0.1)
+	reduced, im2 := DouglasPeuckerIndexMap(p1, 0.5)
+	checkIndexMap(t, "merged", path, reduced, MergeIndexMaps(im1, im2))
+}
+
+// checkIndexMap makes sure the index map is strictly increasing and
+// maps each reduced point to the exact same original point.
+func checkIndexMap(t *testing.T, name string, original, reduced *geo.Path, indexMap []int) {
+	if len(indexMap) != reduced.Length() {
+		t.Fatalf("%s index map length incorrect, expected %d, got %d", name, reduced.Length(), len(indexMap))
+	}
+
+	for i, v := range indexMap {
+		if i > 0 && v <= indexMap[i-1] {
+			t.Errorf("%s index map not strictly increasing at %d: %d <= %d", name, i, v, indexMap[i-1])
+		}
+
+		if !reduced.GetAt(i).Equals(original.GetAt(v)) {
+			t.Errorf("%s index map point mismatch at %d: %v != %v", name, i, reduced.GetAt(i), original.GetAt(v))
+		}
+	}
 }
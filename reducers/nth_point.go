@@ -0,0 +1,95 @@
+package reducers
+
+import (
+	"github.com/paulmach/go.geo"
+)
+
+// A NthPointReducer wraps the NthPoint function
+// to fulfill the geo.Reducer and geo.GeoReducer interfaces.
+type NthPointReducer struct {
+	N int
+}
+
+// NewNthPointReducer creates a new NthPointReducer.
+func NewNthPointReducer(n int) *NthPointReducer {
+	return &NthPointReducer{
+		N: n,
+	}
+}
+
+// Reduce runs the NthPoint reduction using the N of the NthPointReducer.
+func (r NthPointReducer) Reduce(path *geo.Path) *geo.Path {
+	return NthPoint(path, r.N)
+}
+
+// GeoReduce runs the NthPoint reduction. Since only the point indexes matter
+// this is the same as Reduce.
+func (r NthPointReducer) GeoReduce(path *geo.Path) *geo.Path {
+	return NthPoint(path, r.N)
+}
+
+// NthPoint reduces the path by keeping every nth point. The first and last
+// points are always kept. Meant as a cheap pre-pass before a more expensive reducer.
+// Returns a new path and DOES NOT modify the original. If no points are removed,
+// ie. n is 1 or there are 2 or fewer points, the original path is returned
+// so nothing is allocated.
+func NthPoint(path *geo.Path, n int) *geo.Path {
+	p, _ := nthPointCore(path, n, false)
+	return p
+}
+
+// NthPointIndexMap is similar to NthPoint but returns an array that maps
+// each new path index to its original path index.
+// Returns a new path, or the original if no points are removed, and DOES NOT modify the original.
+func NthPointIndexMap(path *geo.Path, n int) (*geo.Path, []int) {
+	return nthPointCore(path, n, true)
+}
+
+func nthPointCore(path *geo.Path, n int, needIndexMap bool) (*geo.Path, []int) {
+	if n <= 0 {
+		panic("n must be > 0")
+	}
+
+	points := path.Points()
+	if len(points) <= 2 || n == 1 {
+		var indexMap []int
+		if needIndexMap {
+			indexMap = make([]int, len(points))
+			for i := range indexMap {
+				indexMap[i] = i
+			}
+		}
+
+		return path, indexMap
+	}
+
+	// every nth plus the last one if it doesn't land on n
+	count := (len(points)-1)/n + 1
+	if (len(points)-1)%n != 0 {
+		count++
+	}
+
+	newPoints := make([]geo.Point, 0, count)
+
+	var indexMap []int
+	if needIndexMap {
+		indexMap = make([]int, 0, count)
+	}
+
+	for i := 0; i < len(points); i += n {
+		newPoints = append(newPoints, points[i])
+		if needIndexMap {
+			indexMap = append(indexMap, i)
+		}
+	}
+
+	if (len(points)-1)%n != 0 {
+		newPoints = append(newPoints, points[len(points)-1])
+		if needIndexMap {
+			indexMap = append(indexMap, len(points)-1)
+		}
+	}
+
+	p := &geo.Path{}
+	return p.SetPoints(newPoints), indexMap
+}
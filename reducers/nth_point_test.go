@@ -0,0 +1,104 @@
+package reducers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/go.geo"
+)
+
+func TestNthPoint(t *testing.T) {
+	p := geo.NewPath()
+	if NthPoint(p, 2).Length() != 0 {
+		t.Error("nthPoint could not reduce zero length path")
+	}
+
+	for i := 0; i < 5; i++ {
+		p.Push(geo.NewPoint(0, float64(i)))
+	}
+
+	reduced := NthPoint(p, 1)
+	if !reduced.Equals(p) {
+		t.Error("nthPoint should not reduce with n = 1")
+	}
+
+	if reduced != p {
+		t.Error("nthPoint should return the original path if nothing is removed")
+	}
+
+	// lands on the last point
+	if l := NthPoint(p, 2).Length(); l != 3 {
+		t.Errorf("nthPoint reduce to incorrect number of points, expected 3, got %d", l)
+	}
+
+	// last point must be added
+	reduced = NthPoint(p, 3)
+	if l := reduced.Length(); l != 3 {
+		t.Errorf("nthPoint reduce to incorrect number of points, expected 3, got %d", l)
+	}
+
+	if !reduced.GetAt(2).Equals(p.GetAt(4)) {
+		t.Errorf("nthPoint should keep the last point, got %v", reduced.GetAt(2))
+	}
+
+	if l := NthPoint(p, 10).Length(); l != 2 {
+		t.Errorf("nthPoint reduce to incorrect number of points, expected 2, got %d", l)
+	}
+
+	if reduced = NthPoint(p, 2); reduced == p || p.Length() != 5 {
+		t.Error("should create new path and not modify original")
+	}
+}
+
+func TestNthPointNoAllocs(t *testing.T) {
+	p := geo.NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(geo.NewPoint(0, float64(i)))
+	}
+
+	short := geo.NewPath().Push(geo.NewPoint(0, 0)).Push(geo.NewPoint(1, 1))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		NthPoint(p, 1)
+		NthPoint(short, 3)
+	})
+
+	if allocs != 0 {
+		t.Errorf("nthPoint should not allocate when nothing is removed, got %v", allocs)
+	}
+}
+
+func TestNthPointIndexMap(t *testing.T) {
+	p := geo.NewPath()
+	if reduced, im := NthPointIndexMap(p, 2); reduced.Length() != 0 || len(im) != 0 {
+		t.Error("nthPointIndexMap could not reduce zero length path")
+	}
+
+	for i := 0; i < 6; i++ {
+		p.Push(geo.NewPoint(0, float64(i)))
+	}
+
+	reduced, im := NthPointIndexMap(p, 2)
+	if reduced.Length() != 4 {
+		t.Error("nthPointIndexMap reduce to incorrect number of points")
+	}
+
+	if !reflect.DeepEqual(im, []int{0, 2, 4, 5}) {
+		t.Errorf("nthPointIndexMap reduce bad index map, got %v", im)
+	}
+
+	_, im = NthPointIndexMap(p, 1)
+	if !reflect.DeepEqual(im, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("nthPointIndexMap reduce bad index map, got %v", im)
+	}
+}
+
+func TestNthPointPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("nthPoint should panic for n <= 0")
+		}
+	}()
+
+	NthPoint(geo.NewPath(), 0)
+}
@@ -57,7 +57,8 @@ func (r RadialGeoReducer) GeoReduce(path *geo.Path) *geo.Path {
 }
 
 // Radial peforms a radial distance polyline simplification using a standard euclidean distance.
-// Returns a new path and DOES NOT modify the original.
+// Returns a new path and DOES NOT modify the original. If no points are removed
+// the original path is returned so nothing is allocated.
 func Radial(path *geo.Path, meters float64) *geo.Path {
 	p, _ := radialCore(path, meters*meters, squaredDistance, false)
 	return p
@@ -72,7 +73,8 @@ func RadialIndexMap(path *geo.Path, meters float64) (*geo.Path, []int) {
 
 // RadialGeo peforms a radial distance polyline simplification using the GeoDistance.
 // ie. the path points must be lng/lat points otherwise the behavior of this function is undefined.
-// Returns a new path and DOES NOT modify the original.
+// Returns a new path and DOES NOT modify the original. If no points are removed
+// the original path is returned so nothing is allocated.
 func RadialGeo(path *geo.Path, meters float64) *geo.Path {
 	p, _ := radialCore(path, meters, geoDistance, false)
 	return p
@@ -92,49 +94,54 @@ func radialCore(
 	needIndexMap bool,
 ) (*geo.Path, []int) {
 
-	// initial sanity checks
-	if path.Length() == 0 {
-		return path.Clone(), []int{}
-	}
-
-	if path.Length() == 1 {
-		return path.Clone(), []int{0}
-	}
-
-	if path.Length() == 2 {
-		return path.Clone(), []int{0, 1}
-	}
-
-	var newPoints []geo.Point
-	var indexMap []int
-
 	points := path.Points()
-	newPoints = append(newPoints, points[0])
 
+	var indexMap []int
 	if needIndexMap {
-		indexMap = append(indexMap, 0)
+		indexMap = make([]int, 0, len(points))
 	}
 
-	// split it up this way because I think it's faster
-	// TODO: test this assumption
+	// newPoints is only allocated once a point is removed,
+	// with enough capacity so nothing is copied around after that.
+	var newPoints []geo.Point
+
 	currentIndex := 0
-	if needIndexMap {
-		for i := 1; i < len(points); i++ {
-			if dist(&points[currentIndex], &points[i]) > meters {
-				currentIndex = i
-				indexMap = append(indexMap, currentIndex)
+	for i := 1; i < len(points); i++ {
+		if dist(&points[currentIndex], &points[i]) > meters {
+			currentIndex = i
+			if newPoints != nil {
 				newPoints = append(newPoints, points[i])
+				if needIndexMap {
+					indexMap = append(indexMap, i)
+				}
 			}
+
+			continue
 		}
-	} else {
-		for i := 1; i < len(points); i++ {
-			if dist(&points[currentIndex], &points[i]) > meters {
-				currentIndex = i
-				newPoints = append(newPoints, points[i])
+
+		// the last point is always kept
+		if newPoints == nil && i != len(points)-1 {
+			newPoints = make([]geo.Point, i, len(points))
+			copy(newPoints, points[:i])
+
+			if needIndexMap {
+				for j := 0; j < i; j++ {
+					indexMap = append(indexMap, j)
+				}
 			}
 		}
 	}
 
+	if newPoints == nil {
+		if needIndexMap {
+			for j := range points {
+				indexMap = append(indexMap, j)
+			}
+		}
+
+		return path, indexMap
+	}
+
 	if currentIndex != len(points)-1 {
 		newPoints = append(newPoints, points[len(points)-1])
 		if needIndexMap {
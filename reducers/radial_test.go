@@ -38,6 +38,36 @@ func TestRadial(t *testing.T) {
 	}
 }
 
+func TestRadialNoAllocs(t *testing.T) {
+	p := geo.NewPath()
+	for i := 0; i < 5; i++ {
+		p.Push(geo.NewPoint(0, float64(i)))
+	}
+
+	// the last point is always kept, so a short last segment removes nothing
+	p.Push(geo.NewPoint(0, 4.5))
+
+	if reduced := Radial(p, 0.9); reduced != p {
+		t.Errorf("radial should return the original path if nothing is removed, got %v", reduced)
+	}
+
+	geoPath := geo.NewPath().Push(geo.NewPoint(-122.4, 37.8)).Push(geo.NewPoint(-122.3, 37.8)).Push(geo.NewPoint(-122.2, 37.8))
+	if reduced := RadialGeo(geoPath, 10); reduced != geoPath {
+		t.Errorf("radial geo should return the original path if nothing is removed, got %v", reduced)
+	}
+
+	empty := geo.NewPath()
+	allocs := testing.AllocsPerRun(100, func() {
+		Radial(p, 0.9)
+		RadialGeo(geoPath, 10)
+		Radial(empty, 1)
+	})
+
+	if allocs != 0 {
+		t.Errorf("radial should not allocate when nothing is removed, got %v", allocs)
+	}
+}
+
 func TestRadialIndexMap(t *testing.T) {
 	p := geo.NewPath()
 	if reduced, _ := RadialIndexMap(p, 1.0); reduced.Length() != 0 {
@@ -59,18 +59,47 @@ func VisvalingamKeep(path *geo.Path, toKeep int) *geo.Path {
 // To just use the threshold, set minPointsToKeep to zero
 // To just use minPointsToKeep, set the threshold to something big like math.MaxFloat64
 //
+// The endpoints are always kept. If the path is closed, ie. the first and last points
+// are equal, at least 4 points are kept so the result is still a valid ring.
+//
 // http://bost.ocks.org/mike/simplify/
 func Visvalingam(path *geo.Path, threshold float64, minPointsToKeep int) *geo.Path {
+	p, _ := visvalingamCore(path, threshold, minPointsToKeep, false)
+	return p
+}
+
+// VisvalingamIndexMap is similar to Visvalingam but returns an array that maps
+// each new path index to its original path index.
+// Returns a new path and DOES NOT modify the original.
+func VisvalingamIndexMap(path *geo.Path, threshold float64, minPointsToKeep int) (*geo.Path, []int) {
+	return visvalingamCore(path, threshold, minPointsToKeep, true)
+}
+
+func visvalingamCore(
+	path *geo.Path,
+	threshold float64,
+	minPointsToKeep int,
+	needIndexMap bool,
+) (*geo.Path, []int) {
 	if threshold < 0 {
 		panic("threshold must be >= 0")
 	}
 
-	if path.Length() <= minPointsToKeep {
-		return path.Clone()
+	// closed paths, rings, need 4 points to not collapse
+	if l := path.Length(); l > 1 && minPointsToKeep < 4 && path.GetAt(0).Equals(path.GetAt(l-1)) {
+		minPointsToKeep = 4
 	}
 
-	if path.Length() <= 2 {
-		return path.Clone()
+	if path.Length() <= minPointsToKeep || path.Length() <= 2 {
+		var indexMap []int
+		if needIndexMap {
+			indexMap = make([]int, path.Length())
+			for i := range indexMap {
+				indexMap[i] = i
+			}
+		}
+
+		return path.Clone(), indexMap
 	}
 
 	// edge cases checked, get on with it
@@ -155,15 +184,24 @@ func Visvalingam(path *geo.Path, threshold float64, minPointsToKeep int) *geo.Pa
 	}
 
 	item := linkedListStart
-	newPoints := make([]geo.Point, 0, len(heap)+2)
+	newPoints := make([]geo.Point, 0, numPoints-removed)
+
+	var indexMap []int
+	if needIndexMap {
+		indexMap = make([]int, 0, numPoints-removed)
+	}
 
 	for item != nil {
 		newPoints = append(newPoints, points[item.pointIndex])
+		if needIndexMap {
+			indexMap = append(indexMap, item.pointIndex)
+		}
+
 		item = item.next
 	}
 
 	reduced := &geo.Path{}
-	return reduced.SetPoints(newPoints)
+	return reduced.SetPoints(newPoints), indexMap
 }
 
 // Stuff to create the priority queue, or min heap.
@@ -1,6 +1,7 @@
 package reducers
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/paulmach/go.geo"
@@ -140,6 +141,105 @@ func TestVisvalingam(t *testing.T) {
 	}
 }
 
+func TestVisvalingamReference(t *testing.T) {
+	p := geo.NewPath()
+	p.Push(geo.NewPoint(0, 0))
+	p.Push(geo.NewPoint(1, 0.1))
+	p.Push(geo.NewPoint(2, 0))
+	p.Push(geo.NewPoint(3, 5))
+	p.Push(geo.NewPoint(4, 0))
+	p.Push(geo.NewPoint(5, 0.1))
+	p.Push(geo.NewPoint(6, 0))
+
+	expected := geo.NewPath()
+	expected.Push(geo.NewPoint(0, 0))
+	expected.Push(geo.NewPoint(2, 0))
+	expected.Push(geo.NewPoint(3, 5))
+	expected.Push(geo.NewPoint(4, 0))
+	expected.Push(geo.NewPoint(6, 0))
+
+	if reduced := VisvalingamThreshold(p, 1.0); !reduced.Equals(expected) {
+		t.Errorf("visvalingam reference incorrect, got %v", reduced)
+	}
+
+	if reduced := VisvalingamKeep(p, 5); !reduced.Equals(expected) {
+		t.Errorf("visvalingam reference incorrect, got %v", reduced)
+	}
+
+	expected = geo.NewPath()
+	expected.Push(geo.NewPoint(0, 0))
+	expected.Push(geo.NewPoint(3, 5))
+	expected.Push(geo.NewPoint(6, 0))
+
+	if reduced := VisvalingamKeep(p, 3); !reduced.Equals(expected) {
+		t.Errorf("visvalingam reference incorrect, got %v", reduced)
+	}
+}
+
+func TestVisvalingamRing(t *testing.T) {
+	p := geo.NewPath()
+	p.Push(geo.NewPoint(0, 0))
+	p.Push(geo.NewPoint(1, 0))
+	p.Push(geo.NewPoint(1.1, 0.5))
+	p.Push(geo.NewPoint(1, 1))
+	p.Push(geo.NewPoint(0, 1))
+	p.Push(geo.NewPoint(0, 0))
+
+	reduced := VisvalingamKeep(p, 2)
+	if l := reduced.Length(); l != 4 {
+		t.Errorf("visvalingam should not collapse ring, expected 4, got %d", l)
+	}
+
+	if !reduced.GetAt(0).Equals(reduced.GetAt(reduced.Length() - 1)) {
+		t.Errorf("visvalingam should keep ring closed, got %v", reduced)
+	}
+
+	reduced = VisvalingamThreshold(p, 100)
+	if l := reduced.Length(); l != 4 {
+		t.Errorf("visvalingam should not collapse ring, expected 4, got %d", l)
+	}
+
+	reduced = VisvalingamThreshold(p, 0.01)
+	if l := reduced.Length(); l != 6 {
+		t.Errorf("visvalingam reduce to incorrect number of points, expected 6, got %d", l)
+	}
+}
+
+func TestVisvalingamIndexMap(t *testing.T) {
+	p := geo.NewPath()
+	if reduced, im := VisvalingamIndexMap(p, 0.1, 0); reduced.Length() != 0 || len(im) != 0 {
+		t.Error("visvalingamIndexMap could not reduce zero length path")
+	}
+
+	p.Push(geo.NewPoint(0.0, 0.0))
+	p.Push(geo.NewPoint(1.0, 1.0))
+	p.Push(geo.NewPoint(0.0, 2.0))
+	p.Push(geo.NewPoint(1.0, 3.0))
+	p.Push(geo.NewPoint(0.0, 4.0))
+
+	reduced, im := VisvalingamIndexMap(p, 1.1, 0)
+	if reduced.Length() != 2 {
+		t.Error("visvalingamIndexMap reduce to incorrect number of points")
+	}
+
+	if !reflect.DeepEqual(im, []int{0, 4}) {
+		t.Errorf("visvalingamIndexMap reduce bad index map, got %v", im)
+	}
+
+	reduced, im = VisvalingamIndexMap(p, 0.9, 0)
+	if reduced.Length() != 5 {
+		t.Error("visvalingamIndexMap reduce to incorrect number of points")
+	}
+
+	if !reflect.DeepEqual(im, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("visvalingamIndexMap reduce bad index map, got %v", im)
+	}
+
+	if reduced == p {
+		t.Error("should create new path and not modify original")
+	}
+}
+
 func TestVisvalingamPanic(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
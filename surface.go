@@ -57,8 +57,9 @@ func (s *Surface) PointAt(x, y int) *Point {
 	return p
 }
 
-// ValueAt returns the bi-linearly interpolated value for
-// the given point. Returns 0 if the point is out of surface bounds
+// ValueAt returns the bi-linearly interpolated value for the given point,
+// from the four surrounding grid points. Planar surfaces are recovered exactly.
+// Returns 0 if the point is out of surface bounds, points on the edge are inside.
 // TODO: cleanup and optimize this code
 func (s *Surface) ValueAt(point *Point) float64 {
 	if !s.bound.Contains(point) {
@@ -84,8 +85,34 @@ func (s *Surface) ValueAt(point *Point) float64 {
 	return w1*(1-h) + w2*h
 }
 
+// BicubicAt returns the bi-cubicly interpolated value for the given point,
+// using Catmull-Rom weights over the surrounding 4x4 grid points. This is smoother
+// than ValueAt, without the facets at the grid lines. Points in the outermost
+// grid cells, where the 4x4 grid points are not all available, fall back to ValueAt.
+// Returns 0 if the point is out of surface bounds.
+func (s *Surface) BicubicAt(point *Point) float64 {
+	if !s.bound.Contains(point) {
+		return 0
+	}
+
+	xi, yi, w, h := s.gridCoordinate(point)
+	if xi < 1 || yi < 1 || xi+2 > s.Width-1 || yi+2 > s.Height-1 {
+		return s.ValueAt(point)
+	}
+
+	var column [4]float64
+	for i := range column {
+		g := s.Grid[xi-1+i]
+		column[i] = catmullRom(g[yi-1], g[yi], g[yi+1], g[yi+2], h)
+	}
+
+	return catmullRom(column[0], column[1], column[2], column[3], w)
+}
+
 // GradientAt returns the surface gradient at the given point.
-// Bilinearlly interpolates the grid cell to find the gradient.
+// Bilinearlly interpolates the grid cell to find the gradient, the same as
+// central differences of ValueAt within the cell.
+// Returns a zero gradient if the point is out of surface bounds.
 func (s *Surface) GradientAt(point *Point) *Point {
 	if !s.bound.Contains(point) {
 		return NewPoint(0, 0)
@@ -150,6 +177,12 @@ func (s *Surface) WriteOffFile(w io.Writer) {
 	w.Write(faces.Bytes())
 }
 
+// catmullRom interpolates between p1 and p2, with t in [0, 1],
+// using the neighboring p0 and p3 for the tangents.
+func catmullRom(p0, p1, p2, p3, t float64) float64 {
+	return p1 + 0.5*t*(p2-p0+t*(2*p0-5*p1+4*p2-p3+t*(3*(p1-p2)+p3-p0)))
+}
+
 // gridBoxWidth returns the width of a grid element in the units of s.Bound.
 func (s Surface) gridBoxWidth() float64 {
 	return s.bound.Width() / float64(s.Width-1)
@@ -0,0 +1,107 @@
+package geo
+
+import "fmt"
+
+// A ProfilePoint is a value of a surface the given distance along a path.
+type ProfilePoint struct {
+	Distance, Value float64
+}
+
+// ProfileAlong returns the surface values, see ValueAt, at samples evenly spaced
+// points along the path, including the first and last points. The path is not modified.
+// Returns an error identifying the first path point, or sample, outside the surface bound.
+// Panics if samples is less than 2. Assumes euclidean geometry.
+func (s *Surface) ProfileAlong(path *Path, samples int) ([]ProfilePoint, error) {
+	if samples < 2 {
+		panic("geo: profile samples must be at least 2")
+	}
+
+	if len(path.PointSet) == 0 {
+		return nil, nil
+	}
+
+	// samples may skip over the vertexes
+	for i := range path.PointSet {
+		if !s.bound.Contains(&path.PointSet[i]) {
+			return nil, fmt.Errorf("geo: path point %d, %v, is outside the surface bound", i, path.PointSet[i])
+		}
+	}
+
+	total := path.Distance()
+	profile := make([]ProfilePoint, samples)
+	for i := range profile {
+		distance := total * float64(i) / float64(samples-1)
+		if i == samples-1 {
+			distance = total
+		}
+
+		point := path.PointAtDistance(distance)
+		if !s.bound.Contains(point) {
+			return nil, fmt.Errorf("geo: profile point %v, at distance %g, is outside the surface bound", point, distance)
+		}
+
+		profile[i] = ProfilePoint{distance, s.ValueAt(point)}
+	}
+
+	return profile, nil
+}
+
+// GainLossAlong returns the sum of the increases, and of the decreases, in the
+// surface value between the points of the path. Loss is positive. To suppress noise
+// the values are smoothed by a centered moving average over window points,
+// 0 or 1 for no smoothing. Long segments may miss changes in between, resample
+// the path to add detail. Returns an error identifying the first path point
+// outside the surface bound. Panics if the window is even and greater than 1.
+func (s *Surface) GainLossAlong(path *Path, window int) (gain, loss float64, err error) {
+	if window > 1 && window%2 == 0 {
+		panic("geo: moving average window must be odd")
+	}
+
+	values := make([]float64, len(path.PointSet))
+	for i := range path.PointSet {
+		if !s.bound.Contains(&path.PointSet[i]) {
+			return 0, 0, fmt.Errorf("geo: path point %d, %v, is outside the surface bound", i, path.PointSet[i])
+		}
+
+		values[i] = s.ValueAt(&path.PointSet[i])
+	}
+
+	if window > 1 {
+		values = movingAverage(values, window)
+	}
+
+	for i := 1; i < len(values); i++ {
+		if d := values[i] - values[i-1]; d > 0 {
+			gain += d
+		} else {
+			loss -= d
+		}
+	}
+
+	return gain, loss, nil
+}
+
+// movingAverage returns the average of the values in the window centered
+// on each value. The window must be odd and is truncated at the ends.
+func movingAverage(values []float64, window int) []float64 {
+	result := make([]float64, len(values))
+	for i := range values {
+		start, end := i-window/2, i+window/2
+		if start < 0 {
+			start = 0
+		}
+
+		if end > len(values)-1 {
+			end = len(values) - 1
+		}
+
+		sum := 0.0
+		for j := start; j <= end; j++ {
+			sum += values[j]
+		}
+
+		result[i] = sum / float64(end-start+1)
+	}
+
+	return result
+}
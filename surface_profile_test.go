@@ -0,0 +1,125 @@
+package geo
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// testTiltedSurface returns a surface of the plane f(x, y) = 2x + y + 3.
+func testTiltedSurface() *Surface {
+	surface := NewSurface(NewBound(0, 10, 0, 5), 11, 6)
+	for x := 0; x < surface.Width; x++ {
+		for y := 0; y < surface.Height; y++ {
+			p := surface.PointAt(x, y)
+			surface.Grid[x][y] = 2*p[0] + p[1] + 3
+		}
+	}
+
+	return surface
+}
+
+func TestSurfaceProfileAlong(t *testing.T) {
+	surface := testTiltedSurface()
+	path := NewPathFromXYData([][2]float64{{0, 1}, {4, 1}, {4, 4}})
+
+	profile, err := surface.ProfileAlong(path, 8)
+	if err != nil {
+		t.Fatalf("surface, profile error: %v", err)
+	}
+
+	if len(profile) != 8 {
+		t.Fatalf("surface, profile expected 8 samples, got %d", len(profile))
+	}
+
+	for i, p := range profile {
+		if math.Abs(p.Distance-float64(i)) > epsilon {
+			t.Errorf("surface, profile sample %d distance expected %v, got %v", i, i, p.Distance)
+		}
+
+		point := path.PointAtDistance(float64(i))
+		if expected := 2*point[0] + point[1] + 3; math.Abs(p.Value-expected) > epsilon {
+			t.Errorf("surface, profile sample %d value expected %v, got %v", i, expected, p.Value)
+		}
+	}
+
+	if path.Length() != 3 {
+		t.Errorf("surface, profile should not modify the path, got %v", path)
+	}
+
+	// outside the bound
+	path = NewPathFromXYData([][2]float64{{0, 1}, {12, 1}})
+	if _, err := surface.ProfileAlong(path, 7); err == nil || !strings.Contains(err.Error(), "point 1") {
+		t.Errorf("surface, profile outside the bound should be reported, got %v", err)
+	}
+
+	// vertex outside the bound between samples
+	path = NewPathFromXYData([][2]float64{{0, 1}, {5, 6}, {10, 1}})
+	if _, err := surface.ProfileAlong(path, 2); err == nil || !strings.Contains(err.Error(), "point 1") {
+		t.Errorf("surface, profile vertex outside the bound should be reported, got %v", err)
+	}
+
+	if profile, err := surface.ProfileAlong(NewPath(), 5); profile != nil || err != nil {
+		t.Errorf("surface, profile of empty path should be empty, got %v %v", profile, err)
+	}
+}
+
+func TestSurfaceProfileAlongPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("surface, profile with less than 2 samples should panic")
+		}
+	}()
+
+	testTiltedSurface().ProfileAlong(NewPathFromXYData([][2]float64{{0, 1}, {4, 1}}), 1)
+}
+
+func TestSurfaceGainLossAlongPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("surface, gain loss with an even window should panic")
+		}
+	}()
+
+	testTiltedSurface().GainLossAlong(NewPathFromXYData([][2]float64{{0, 1}, {4, 1}}), 2)
+}
+
+func TestSurfaceGainLossAlong(t *testing.T) {
+	surface := testTiltedSurface()
+
+	// up 8 + 3, down 6 + 2
+	path := NewPathFromXYData([][2]float64{{0, 1}, {4, 1}, {4, 4}, {1, 4}, {1, 2}})
+
+	gain, loss, err := surface.GainLossAlong(path, 0)
+	if err != nil || gain != 11 || loss != 8 {
+		t.Errorf("surface, gain loss expected 11 8, got %v %v %v", gain, loss, err)
+	}
+
+	// the same after resampling through the corners, the surface is planar
+	resampled := path.Clone().Resample(13)
+	if g, l, _ := surface.GainLossAlong(resampled, 1); math.Abs(g-gain) > 1e-9 || math.Abs(l-loss) > 1e-9 {
+		t.Errorf("surface, gain loss of resampled path expected %v %v, got %v %v", gain, loss, g, l)
+	}
+
+	// smoothing suppresses noise
+	noisy := NewPath()
+	for i := 0; i <= 20; i++ {
+		noisy.Push(NewPoint(float64(i)/4, 2+float64(i%2)))
+	}
+
+	rawGain, rawLoss, _ := surface.GainLossAlong(noisy, 0)
+	if math.Abs(rawGain-15) > epsilon || math.Abs(rawLoss-5) > epsilon {
+		t.Errorf("surface, gain loss of noisy path expected 15 5, got %v %v", rawGain, rawLoss)
+	}
+
+	smoothGain, smoothLoss, _ := surface.GainLossAlong(noisy, 3)
+	if smoothGain < 9.5 || smoothGain > 10.5 || smoothLoss != 0 {
+		t.Errorf("surface, smoothed gain loss should be near 10 0, got %v %v", smoothGain, smoothLoss)
+	}
+
+	// outside the bound
+	path = NewPathFromXYData([][2]float64{{0, 1}, {4, 1}, {4, -1}})
+	if _, _, err := surface.GainLossAlong(path, 0); err == nil || !strings.Contains(err.Error(), "point 2") {
+		t.Errorf("surface, gain loss outside the bound should be reported, got %v", err)
+	}
+}
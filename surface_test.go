@@ -3,6 +3,7 @@ package geo
 import (
 	"bytes"
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -152,6 +153,101 @@ func TestSurfaceGradientAt(t *testing.T) {
 	}
 }
 
+func TestSurfacePlanar(t *testing.T) {
+	bound := NewBound(-3, 5, 1, 4)
+	surface := NewSurface(bound, 9, 7)
+
+	a, b, c := 2.5, -1.25, 3.0
+	for x := 0; x < surface.Width; x++ {
+		for y := 0; y < surface.Height; y++ {
+			p := surface.PointAt(x, y)
+			surface.Grid[x][y] = a*p[0] + b*p[1] + c
+		}
+	}
+
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 100; i++ {
+		p := NewPoint(-3+8*r.Float64(), 1+3*r.Float64())
+		if i == 0 {
+			p = bound.NorthEast()
+		}
+
+		if v, expected := surface.ValueAt(p), a*p[0]+b*p[1]+c; math.Abs(v-expected) > epsilon {
+			t.Errorf("surface, planar value at %v expected %v, got %v", p, expected, v)
+		}
+
+		if g := surface.GradientAt(p); areaPointsDifferent(g, NewPoint(a, b), epsilon) {
+			t.Errorf("surface, planar gradient at %v expected %v, got %v", p, NewPoint(a, b), g)
+		}
+	}
+
+	// outside the bound
+	p := NewPoint(6, 2)
+	if v := surface.ValueAt(p); v != 0 {
+		t.Errorf("surface, value outside the bound should be 0, got %v", v)
+	}
+
+	if g := surface.GradientAt(p); !g.Equals(NewPoint(0, 0)) {
+		t.Errorf("surface, gradient outside the bound should be 0, got %v", g)
+	}
+}
+
+func TestSurfaceBicubicAt(t *testing.T) {
+	bound := NewBound(0, 4, 0, 3)
+	surface := NewSurface(bound, 17, 13)
+
+	f := func(p *Point) float64 { return math.Sin(p[0]) * math.Cos(p[1]) }
+	for x := 0; x < surface.Width; x++ {
+		for y := 0; y < surface.Height; y++ {
+			surface.Grid[x][y] = f(surface.PointAt(x, y))
+		}
+	}
+
+	// grid points are exact
+	for x := 0; x < surface.Width; x++ {
+		for y := 0; y < surface.Height; y++ {
+			p := surface.PointAt(x, y)
+			if v := surface.BicubicAt(p); math.Abs(v-surface.Grid[x][y]) > epsilon {
+				t.Errorf("surface, bicubic at grid point %v expected %v, got %v", p, surface.Grid[x][y], v)
+			}
+		}
+	}
+
+	// cell centers are more accurate than bilinear, for every cell
+	var bicubicError, bilinearError float64
+	for x := 0; x < surface.Width-1; x++ {
+		for y := 0; y < surface.Height-1; y++ {
+			p := surface.PointAt(x, y)
+			p[0] += surface.gridBoxWidth() / 2
+			p[1] += surface.gridBoxHeight() / 2
+
+			cubic, linear := surface.BicubicAt(p), surface.ValueAt(p)
+			if x == 0 || y == 0 || x == surface.Width-2 || y == surface.Height-2 {
+				if cubic != linear {
+					t.Errorf("surface, bicubic in outermost cell should be bilinear, got %v, expected %v", cubic, linear)
+				}
+				continue
+			}
+
+			bicubicError += math.Abs(cubic - f(p))
+			bilinearError += math.Abs(linear - f(p))
+		}
+	}
+
+	if bicubicError >= bilinearError/4 {
+		t.Errorf("surface, bicubic error %v should be much less than bilinear %v", bicubicError, bilinearError)
+	}
+
+	// the edges and outside the bound
+	if v := surface.BicubicAt(bound.NorthEast()); math.Abs(v-f(bound.NorthEast())) > epsilon {
+		t.Errorf("surface, bicubic at the corner incorrect, got %v", v)
+	}
+
+	if v := surface.BicubicAt(NewPoint(5, 1)); v != 0 {
+		t.Errorf("surface, bicubic outside the bound should be 0, got %v", v)
+	}
+}
+
 func TestSurfaceWriteOffFile(t *testing.T) {
 	bound := NewBound(3, 0, 3, 0)
 	surface := NewSurface(bound, 4, 4)
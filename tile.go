@@ -0,0 +1,180 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrTooManyTiles is returned when a bound covers more than the allowed number of tiles.
+var ErrTooManyTiles = errors.New("go.geo: too many tiles")
+
+// maxMercatorLatitude is the latitude of the top and bottom edges of the map tiles.
+const maxMercatorLatitude = 85.05112877980659
+
+// tileEpsilon is the rounding error, as a fraction of the world,
+// allowed when finding the tiles at the edges of a bound.
+const tileEpsilon = 1e-12
+
+// A Tile is an online map tile index, same as Google's and OSM's z/x/y scheme.
+type Tile struct {
+	X, Y uint64
+	Z    int
+}
+
+// Bound returns the bound of the tile.
+func (t Tile) Bound() *Bound {
+	return NewBoundFromMapTile(t.X, t.Y, uint64(t.Z))
+}
+
+// Quadkey returns the Bing Maps quadkey of the tile, see NewBoundFromQuadkey.
+func (t Tile) Quadkey() int64 {
+	return tileQuadkey(t.X, t.Y, t.Z)
+}
+
+// TileOptions are the options used when enumerating the tiles covering a bound.
+type TileOptions struct {
+	// MaxTiles is the maximum number of tiles to return, 0 for no limit.
+	MaxTiles int
+}
+
+// Tiles returns the map tiles, at the zoom level, that intersect the bound.
+// Tiles are ordered by row, then column. Edges on a tile boundary do not
+// include the neighboring tile. Latitudes beyond the Mercator limit
+// are clamped. Panics if the zoom is not in [0, 31].
+func (b *Bound) Tiles(zoom int) []Tile {
+	tiles, _ := b.TilesWithOptions(zoom, TileOptions{})
+	return tiles
+}
+
+// Quadkeys returns the quadkeys of the map tiles, at the zoom level,
+// that intersect the bound, in the same order as Tiles.
+// Panics if the zoom is not in [0, 31].
+func (b *Bound) Quadkeys(zoom int) []int64 {
+	keys, _ := b.QuadkeysWithOptions(zoom, TileOptions{})
+	return keys
+}
+
+// QuadkeysWithOptions returns the quadkeys of the map tiles, at the zoom level,
+// that intersect the bound. Returns ErrTooManyTiles if there are more than opts.MaxTiles.
+func (b *Bound) QuadkeysWithOptions(zoom int, opts TileOptions) ([]int64, error) {
+	minX, maxX, minY, maxY := b.tileRange(zoom)
+
+	count := int((maxX - minX + 1) * (maxY - minY + 1))
+	if opts.MaxTiles > 0 && count > opts.MaxTiles {
+		return nil, ErrTooManyTiles
+	}
+
+	keys := make([]int64, 0, count)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			keys = append(keys, tileQuadkey(x, y, zoom))
+		}
+	}
+
+	return keys, nil
+}
+
+// TilesWithOptions returns the map tiles, at the zoom level, that intersect the bound.
+// Returns ErrTooManyTiles if there are more than opts.MaxTiles.
+func (b *Bound) TilesWithOptions(zoom int, opts TileOptions) ([]Tile, error) {
+	minX, maxX, minY, maxY := b.tileRange(zoom)
+
+	count := int((maxX - minX + 1) * (maxY - minY + 1))
+	if opts.MaxTiles > 0 && count > opts.MaxTiles {
+		return nil, ErrTooManyTiles
+	}
+
+	tiles := make([]Tile, 0, count)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			tiles = append(tiles, Tile{x, y, zoom})
+		}
+	}
+
+	return tiles, nil
+}
+
+// TileCount returns the number of map tiles, at the zoom level, that intersect
+// the bound without creating them. Panics if the zoom is not in [0, 31].
+func (b *Bound) TileCount(zoom int) int {
+	minX, maxX, minY, maxY := b.tileRange(zoom)
+	return int((maxX - minX + 1) * (maxY - minY + 1))
+}
+
+// Tiles returns the map tiles, at the zoom level, that intersect the bound.
+// Wrapping bounds include tiles from both edges of the map, see Bound.Tiles.
+func (b *GeoBound) Tiles(zoom int) []Tile {
+	tiles, _ := b.TilesWithOptions(zoom, TileOptions{})
+	return tiles
+}
+
+// TilesWithOptions returns the map tiles, at the zoom level, that intersect the bound.
+// Returns ErrTooManyTiles if there are more than opts.MaxTiles.
+func (b *GeoBound) TilesWithOptions(zoom int, opts TileOptions) ([]Tile, error) {
+	if opts.MaxTiles > 0 && b.TileCount(zoom) > opts.MaxTiles {
+		return nil, ErrTooManyTiles
+	}
+
+	var tiles []Tile
+	for _, bound := range b.SplitAtAntimeridian() {
+		tiles = append(tiles, bound.Tiles(zoom)...)
+	}
+
+	return tiles, nil
+}
+
+// TileCount returns the number of map tiles, at the zoom level, that intersect the bound.
+func (b *GeoBound) TileCount(zoom int) int {
+	count := 0
+	for _, bound := range b.SplitAtAntimeridian() {
+		count += bound.TileCount(zoom)
+	}
+
+	return count
+}
+
+// tileRange returns the inclusive range of tile indexes covering the bound.
+func (b *Bound) tileRange(zoom int) (minX, maxX, minY, maxY uint64) {
+	if zoom < 0 || zoom > 31 {
+		panic("geo: tile zoom must be in [0, 31]")
+	}
+
+	n := float64(uint64(1) << uint(zoom))
+
+	west, north := mercatorTile(b.sw[0], b.ne[1], n)
+	east, south := mercatorTile(b.ne[0], b.sw[1], n)
+
+	minX, maxX = tileIndexRange(west, east, n)
+	minY, maxY = tileIndexRange(north, south, n)
+
+	return
+}
+
+// mercatorTile returns the fractional tile position of the lng/lat.
+func mercatorTile(lng, lat, n float64) (x, y float64) {
+	lat = math.Max(-maxMercatorLatitude, math.Min(maxMercatorLatitude, lat))
+	siny := math.Sin(deg2rad(lat))
+
+	x = (lng/360 + 0.5) * n
+	y = (0.5 - math.Log((1+siny)/(1-siny))/(4*math.Pi)) * n
+
+	return
+}
+
+// tileIndexRange returns the tiles covering the fractional range. The end is
+// exclusive so a range ending on a tile boundary does not include the next tile.
+// Values within rounding error of a boundary are snapped to it.
+func tileIndexRange(start, end, n float64) (uint64, uint64) {
+	if r := math.Floor(start + 0.5); math.Abs(start-r) < n*tileEpsilon {
+		start = r
+	}
+
+	if r := math.Floor(end + 0.5); math.Abs(end-r) < n*tileEpsilon {
+		end = r
+	}
+
+	start = math.Max(0, math.Min(n-1, math.Floor(start)))
+	end = math.Max(start, math.Min(n-1, math.Ceil(end)-1))
+
+	return uint64(start), uint64(end)
+}
@@ -0,0 +1,162 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoundTiles(t *testing.T) {
+	// a bound inside a tile
+	bound := NewBoundFromMapTile(7, 8, 9).Pad(-0.01)
+	if tiles := bound.Tiles(9); !reflect.DeepEqual(tiles, []Tile{{7, 8, 9}}) {
+		t.Errorf("tiles, expected single tile, got %v", tiles)
+	}
+
+	// the tile itself does not include its neighbors
+	for _, z := range []int{0, 1, 9, 20, 31} {
+		tile := Tile{3, 1, z}
+		if z < 2 {
+			tile = Tile{0, 0, z}
+		}
+
+		if tiles := tile.Bound().Tiles(z); !reflect.DeepEqual(tiles, []Tile{tile}) {
+			t.Errorf("tiles, tile bound at zoom %d expected %v, got %v", z, tile, tiles)
+		}
+	}
+
+	// parent tile covers its children
+	tiles := NewBoundFromMapTile(7, 8, 9).Tiles(10)
+	expected := []Tile{{14, 16, 10}, {15, 16, 10}, {14, 17, 10}, {15, 17, 10}}
+	if !reflect.DeepEqual(tiles, expected) {
+		t.Errorf("tiles, children expected %v, got %v", expected, tiles)
+	}
+
+	// the world, beyond the mercator limits
+	bound = NewBound(-180, 180, -90, 90)
+	if c := len(bound.Tiles(3)); c != 64 {
+		t.Errorf("tiles, world expected 64 tiles, got %d", c)
+	}
+
+	if tiles := bound.Tiles(0); !reflect.DeepEqual(tiles, []Tile{{0, 0, 0}}) {
+		t.Errorf("tiles, world at zoom 0 expected single tile, got %v", tiles)
+	}
+
+	// a point
+	bound = NewBound(-122.4194, -122.4194, 37.7749, 37.7749)
+	if tiles := bound.Tiles(12); !reflect.DeepEqual(tiles, []Tile{{655, 1583, 12}}) {
+		t.Errorf("tiles, point expected single tile, got %v", tiles)
+	}
+}
+
+func TestBoundTileCount(t *testing.T) {
+	bound := NewBound(-122.52, -122.35, 37.70, 37.83)
+
+	for z := 0; z < 16; z++ {
+		if c, l := bound.TileCount(z), len(bound.Tiles(z)); c != l {
+			t.Errorf("tiles, count at zoom %d expected %d, got %d", z, l, c)
+		}
+	}
+
+	if c := NewBound(-180, 180, -90, 90).TileCount(31); c != 1<<62 {
+		t.Errorf("tiles, world count at zoom 31 incorrect, got %d", c)
+	}
+}
+
+func TestBoundTilesWithOptions(t *testing.T) {
+	bound := NewBound(-180, 180, -90, 90)
+
+	tiles, err := bound.TilesWithOptions(2, TileOptions{MaxTiles: 16})
+	if err != nil || len(tiles) != 16 {
+		t.Errorf("tiles, expected 16 tiles, got %d, %v", len(tiles), err)
+	}
+
+	tiles, err = bound.TilesWithOptions(20, TileOptions{MaxTiles: 16})
+	if err != ErrTooManyTiles || tiles != nil {
+		t.Errorf("tiles, expected too many tiles error, got %d, %v", len(tiles), err)
+	}
+}
+
+func TestBoundQuadkeys(t *testing.T) {
+	bound := NewBound(-122.52, -122.35, 37.70, 37.83)
+
+	for z := 0; z < 16; z++ {
+		keys := bound.Quadkeys(z)
+		tiles := bound.Tiles(z)
+
+		if len(keys) != len(tiles) {
+			t.Fatalf("tiles, quadkeys at zoom %d expected %d keys, got %d", z, len(tiles), len(keys))
+		}
+
+		union := NewBoundFromQuadkey(keys[0], z)
+		for i, key := range keys {
+			if k := tiles[i].Quadkey(); k != key {
+				t.Errorf("tiles, quadkey at zoom %d expected %d, got %d", z, k, key)
+			}
+
+			tile := NewBoundFromQuadkey(key, z)
+			if !tile.Intersects(bound) {
+				t.Errorf("tiles, quadkey %d at zoom %d should intersect the bound", key, z)
+			}
+
+			union.Union(tile)
+		}
+
+		if !union.Contains(bound.SouthWest()) || !union.Contains(bound.NorthEast()) {
+			t.Errorf("tiles, quadkeys at zoom %d should cover the bound, got %v", z, union)
+		}
+	}
+
+	// same as the point quadkey
+	p := NewPoint(-87.65005229999997, 41.850033)
+	if keys := NewBound(p[0], p[0], p[1], p[1]).Quadkeys(15); len(keys) != 1 || keys[0] != 212521785 {
+		t.Errorf("tiles, quadkey of a point incorrect, got %v", keys)
+	}
+
+	// many tiles
+	if c := len(NewBound(-180, 180, -90, 90).Quadkeys(8)); c != 1<<16 {
+		t.Errorf("tiles, world quadkeys at zoom 8 expected %d, got %d", 1<<16, c)
+	}
+
+	keys, err := NewBound(-180, 180, -90, 90).QuadkeysWithOptions(20, TileOptions{MaxTiles: 1000})
+	if keys != nil || err != ErrTooManyTiles {
+		t.Errorf("tiles, expected too many quadkeys error, got %d, %v", len(keys), err)
+	}
+
+	keys, err = bound.QuadkeysWithOptions(10, TileOptions{MaxTiles: bound.TileCount(10)})
+	if err != nil || !reflect.DeepEqual(keys, bound.Quadkeys(10)) {
+		t.Errorf("tiles, quadkeys within the limit incorrect, got %v, %v", keys, err)
+	}
+}
+
+func TestBoundTilesZoomPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("tiles, should panic for zoom out of range")
+		}
+	}()
+
+	NewBound(0, 1, 0, 1).Tiles(32)
+}
+
+func TestGeoBoundTiles(t *testing.T) {
+	bound := NewGeoBound(179, -179, -1, 1)
+
+	tiles := bound.Tiles(2)
+	expected := []Tile{{3, 1, 2}, {3, 2, 2}, {0, 1, 2}, {0, 2, 2}}
+	if !reflect.DeepEqual(tiles, expected) {
+		t.Errorf("tiles, wrapping bound expected %v, got %v", expected, tiles)
+	}
+
+	if c := bound.TileCount(2); c != 4 {
+		t.Errorf("tiles, wrapping bound count expected 4, got %d", c)
+	}
+
+	if _, err := bound.TilesWithOptions(2, TileOptions{MaxTiles: 3}); err != ErrTooManyTiles {
+		t.Errorf("tiles, wrapping bound expected too many tiles error, got %v", err)
+	}
+
+	tiles = NewGeoBound(10, 20, 10, 20).Tiles(2)
+	if !reflect.DeepEqual(tiles, []Tile{{2, 1, 2}}) {
+		t.Errorf("tiles, non wrapping bound expected single tile, got %v", tiles)
+	}
+}
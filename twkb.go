@@ -0,0 +1,221 @@
+package geo
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrNotTWKB is returned when unmarshalling TWKB and the data is not valid.
+var ErrNotTWKB = errors.New("go.geo: invalid TWKB data")
+
+// TWKB geometry types and metadata header flags,
+// see https://github.com/TWKB/Specification
+const (
+	twkbPoint      = 1
+	twkbLineString = 2
+
+	twkbBound             = 0x01
+	twkbSize              = 0x02
+	twkbExtendedPrecision = 0x08
+	twkbEmpty             = 0x10
+)
+
+// NewPathFromTWKB creates a path from TWKB data, such as the output of
+// PostGIS's ST_AsTWKB. LineString and Point geometries are supported,
+// a Point becomes a single point path. The bounding box header is skipped
+// and any Z or M values are ignored.
+// Returns ErrIncorrectGeometry for other geometry types.
+func NewPathFromTWKB(data []byte) (*Path, error) {
+	_, ps, err := decodeTWKB(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Path{ps}, nil
+}
+
+// NewPointFromTWKB creates a point from TWKB data, such as the output of
+// PostGIS's ST_AsTWKB. The data must be of type Point and not be empty,
+// otherwise ErrIncorrectGeometry is returned.
+func NewPointFromTWKB(data []byte) (*Point, error) {
+	typeCode, ps, err := decodeTWKB(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeCode != twkbPoint || len(ps) == 0 {
+		return nil, ErrIncorrectGeometry
+	}
+
+	return &ps[0], nil
+}
+
+// ToTWKB encodes the path as a TWKB linestring with coordinates rounded
+// to the given number of decimal places. Precision must be between -8 and 7.
+// Empty paths are encoded as an empty linestring.
+func (p *Path) ToTWKB(precision int) []byte {
+	data := make([]byte, 2, 2+binary.MaxVarintLen64*(1+2*len(p.PointSet)))
+	data[0] = twkbHeader(twkbLineString, precision)
+
+	if len(p.PointSet) == 0 {
+		data[1] = twkbEmpty
+		return data
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	data = append(data, buf[:binary.PutUvarint(buf[:], uint64(len(p.PointSet)))]...)
+
+	var prevX, prevY int64
+	for _, point := range p.PointSet {
+		x := twkbInt(point[0], precision)
+		y := twkbInt(point[1], precision)
+
+		data = append(data, buf[:binary.PutVarint(buf[:], x-prevX)]...)
+		data = append(data, buf[:binary.PutVarint(buf[:], y-prevY)]...)
+
+		prevX, prevY = x, y
+	}
+
+	return data
+}
+
+// ToTWKB encodes the point as a TWKB point with coordinates rounded
+// to the given number of decimal places. Precision must be between -8 and 7.
+func (p Point) ToTWKB(precision int) []byte {
+	data := make([]byte, 2, 2+2*binary.MaxVarintLen64)
+	data[0] = twkbHeader(twkbPoint, precision)
+
+	var buf [binary.MaxVarintLen64]byte
+	data = append(data, buf[:binary.PutVarint(buf[:], twkbInt(p[0], precision))]...)
+	data = append(data, buf[:binary.PutVarint(buf[:], twkbInt(p[1], precision))]...)
+
+	return data
+}
+
+func decodeTWKB(data []byte) (byte, PointSet, error) {
+	if len(data) < 2 {
+		return 0, nil, ErrNotTWKB
+	}
+
+	typeCode := data[0] & 0x0f
+	zigzag := int(data[0] >> 4)
+	precision := zigzag>>1 ^ -(zigzag & 1)
+	flags := data[1]
+	data = data[2:]
+
+	if typeCode != twkbPoint && typeCode != twkbLineString {
+		return 0, nil, ErrIncorrectGeometry
+	}
+
+	dimensions := 2
+	if flags&twkbExtendedPrecision != 0 {
+		if len(data) == 0 {
+			return 0, nil, ErrNotTWKB
+		}
+
+		// has z and has m flags
+		dimensions += int(data[0]&1) + int(data[0]>>1&1)
+		data = data[1:]
+	}
+
+	if flags&twkbSize != 0 {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size != uint64(len(data)-n) {
+			return 0, nil, ErrNotTWKB
+		}
+
+		data = data[n:]
+	}
+
+	if flags&twkbEmpty != 0 {
+		if len(data) != 0 {
+			return 0, nil, ErrNotTWKB
+		}
+
+		return typeCode, PointSet{}, nil
+	}
+
+	if flags&twkbBound != 0 {
+		// min and delta for each dimension
+		for i := 0; i < 2*dimensions; i++ {
+			_, n := binary.Varint(data)
+			if n <= 0 {
+				return 0, nil, ErrNotTWKB
+			}
+
+			data = data[n:]
+		}
+	}
+
+	count := uint64(1)
+	if typeCode == twkbLineString {
+		var n int
+		count, n = binary.Uvarint(data)
+		if n <= 0 {
+			return 0, nil, ErrNotTWKB
+		}
+
+		data = data[n:]
+	}
+
+	// every value is at least one byte
+	if count > uint64(len(data)/dimensions) {
+		return 0, nil, ErrNotTWKB
+	}
+
+	ps := make(PointSet, count)
+
+	var values [4]int64
+	for i := range ps {
+		for d := 0; d < dimensions; d++ {
+			delta, n := binary.Varint(data)
+			if n <= 0 {
+				return 0, nil, ErrNotTWKB
+			}
+
+			values[d] += delta
+			data = data[n:]
+		}
+
+		ps[i] = Point{twkbFloat(values[0], precision), twkbFloat(values[1], precision)}
+	}
+
+	if len(data) != 0 {
+		return 0, nil, ErrNotTWKB
+	}
+
+	return typeCode, ps, nil
+}
+
+func twkbHeader(typeCode byte, precision int) byte {
+	if precision < -8 || precision > 7 {
+		panic("geo: twkb precision must be between -8 and 7")
+	}
+
+	return byte((precision<<1)^(precision>>31))<<4 | typeCode
+}
+
+// twkbInt scales the value to the precision and rounds half away from zero,
+// like the llround used by PostGIS.
+func twkbInt(value float64, precision int) int64 {
+	if precision < 0 {
+		value /= math.Pow10(-precision)
+	} else {
+		value *= math.Pow10(precision)
+	}
+
+	if value < 0 {
+		return -int64(math.Floor(-value + 0.5))
+	}
+
+	return int64(math.Floor(value + 0.5))
+}
+
+func twkbFloat(value int64, precision int) float64 {
+	if precision < 0 {
+		return float64(value) * math.Pow10(-precision)
+	}
+
+	return float64(value) / math.Pow10(precision)
+}
@@ -0,0 +1,213 @@
+package geo
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+// postgisTWKB are outputs of PostGIS's ST_AsTWKB(geom, prec, prec_z, prec_m, with_sizes, with_boxes).
+// Encode is set for those ToTWKB should reproduce from the input geometry.
+var postgisTWKB = []struct {
+	sql       string
+	data      []byte
+	input     *Path
+	precision int
+	encode    bool
+	expected  *Path
+}{
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING(1 1,5 5)'::geometry)",
+		data:     []byte{0x02, 0x00, 0x02, 0x02, 0x02, 0x08, 0x08},
+		input:    NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+		encode:   true,
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('POINT(1 2)'::geometry)",
+		data:     []byte{0x01, 0x00, 0x02, 0x04},
+		expected: NewPath().Push(NewPoint(1, 2)),
+	},
+	{
+		sql:       "SELECT ST_AsTWKB('LINESTRING(1.23 4.56,1.24 4.5)'::geometry, 2)",
+		data:      []byte{0x42, 0x00, 0x02, 0xf6, 0x01, 0x90, 0x07, 0x02, 0x0b},
+		input:     NewPath().Push(NewPoint(1.23, 4.56)).Push(NewPoint(1.24, 4.5)),
+		precision: 2,
+		encode:    true,
+		expected:  NewPath().Push(NewPoint(1.23, 4.56)).Push(NewPoint(1.24, 4.5)),
+	},
+	{
+		sql:       "SELECT ST_AsTWKB('LINESTRING(-122.41942 37.77493,-122.41866 37.77551)'::geometry, 5)",
+		data:      []byte{0xa2, 0x00, 0x02, 0xab, 0xb0, 0xd6, 0x0b, 0xaa, 0x8f, 0xcd, 0x03, 0x98, 0x01, 0x74},
+		input:     NewPath().Push(NewPoint(-122.41942, 37.77493)).Push(NewPoint(-122.41866, 37.77551)),
+		precision: 5,
+		encode:    true,
+		expected:  NewPath().Push(NewPoint(-122.41942, 37.77493)).Push(NewPoint(-122.41866, 37.77551)),
+	},
+	{
+		sql:       "SELECT ST_AsTWKB('LINESTRING(123 -38,127 -44)'::geometry, -1)",
+		data:      []byte{0x12, 0x00, 0x02, 0x18, 0x07, 0x02, 0x00},
+		input:     NewPath().Push(NewPoint(123, -38)).Push(NewPoint(127, -44)),
+		precision: -1,
+		encode:    true,
+		expected:  NewPath().Push(NewPoint(120, -40)).Push(NewPoint(130, -40)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING(-0.5 0.5,1.5 -1.5)'::geometry)",
+		data:     []byte{0x02, 0x00, 0x02, 0x01, 0x02, 0x06, 0x05},
+		input:    NewPath().Push(NewPoint(-0.5, 0.5)).Push(NewPoint(1.5, -1.5)),
+		encode:   true,
+		expected: NewPath().Push(NewPoint(-1, 1)).Push(NewPoint(2, -2)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING(1 1,5 5)'::geometry, 0, 0, 0, true, false)",
+		data:     []byte{0x02, 0x02, 0x05, 0x02, 0x02, 0x02, 0x08, 0x08},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING(1 1,5 5)'::geometry, 0, 0, 0, false, true)",
+		data:     []byte{0x02, 0x01, 0x02, 0x08, 0x02, 0x08, 0x02, 0x02, 0x02, 0x08, 0x08},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING(1 1,5 5)'::geometry, 0, 0, 0, true, true)",
+		data:     []byte{0x02, 0x03, 0x09, 0x02, 0x08, 0x02, 0x08, 0x02, 0x02, 0x02, 0x08, 0x08},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING Z (1 1 10,5 5 20)'::geometry)",
+		data:     []byte{0x02, 0x08, 0x01, 0x02, 0x02, 0x02, 0x14, 0x08, 0x08, 0x14},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING Z (1 1 10,5 5 20)'::geometry, 0, 1)",
+		data:     []byte{0x02, 0x08, 0x05, 0x02, 0x02, 0x02, 0xc8, 0x01, 0x08, 0x08, 0xc8, 0x01},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING Z (1 1 10,5 5 20)'::geometry, 0, 0, 0, true, true)",
+		data:     []byte{0x02, 0x0b, 0x01, 0x0d, 0x02, 0x08, 0x02, 0x08, 0x14, 0x14, 0x02, 0x02, 0x02, 0x14, 0x08, 0x08, 0x14},
+		expected: NewPath().Push(NewPoint(1, 1)).Push(NewPoint(5, 5)),
+	},
+	{
+		sql:      "SELECT ST_AsTWKB('LINESTRING EMPTY'::geometry)",
+		data:     []byte{0x02, 0x10},
+		input:    NewPath(),
+		encode:   true,
+		expected: NewPath(),
+	},
+}
+
+func TestNewPathFromTWKB(t *testing.T) {
+	for _, tc := range postgisTWKB {
+		p, err := NewPathFromTWKB(tc.data)
+		if err != nil {
+			t.Errorf("twkb, %s: error: %v", tc.sql, err)
+			continue
+		}
+
+		if !p.ApproxEquals(tc.expected, 1e-10) {
+			t.Errorf("twkb, %s: expected %v, got %v", tc.sql, tc.expected, p)
+		}
+	}
+}
+
+func TestNewPathFromTWKBErrors(t *testing.T) {
+	data := []byte{0x02, 0x00, 0x02, 0x02, 0x02, 0x08, 0x08}
+	for i := 0; i < len(data); i++ {
+		if _, err := NewPathFromTWKB(data[:i]); err != ErrNotTWKB {
+			t.Errorf("twkb, truncated to %d bytes should return not twkb error, got %v", i, err)
+		}
+	}
+
+	errorTests := [][]byte{
+		append(append([]byte{}, data...), 0x00),
+		{0x02, 0x02, 0x04, 0x02, 0x02, 0x02, 0x08, 0x08},
+		{0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x02, 0x02},
+		{0x02, 0x10, 0x00},
+	}
+
+	for i, test := range errorTests {
+		if _, err := NewPathFromTWKB(test); err != ErrNotTWKB {
+			t.Errorf("twkb, test %d should return not twkb error, got %v", i, err)
+		}
+	}
+
+	// polygon
+	if _, err := NewPathFromTWKB([]byte{0x03, 0x10}); err != ErrIncorrectGeometry {
+		t.Errorf("twkb, polygon should return incorrect geometry error, got %v", err)
+	}
+}
+
+func TestNewPointFromTWKB(t *testing.T) {
+	p, err := NewPointFromTWKB([]byte{0x01, 0x00, 0x02, 0x04})
+	if err != nil || !p.Equals(NewPoint(1, 2)) {
+		t.Errorf("twkb, point incorrect, got %v %v", p, err)
+	}
+
+	if _, err := NewPointFromTWKB([]byte{0x02, 0x00, 0x02, 0x02, 0x02, 0x08, 0x08}); err != ErrIncorrectGeometry {
+		t.Errorf("twkb, linestring should return incorrect geometry error, got %v", err)
+	}
+
+	if _, err := NewPointFromTWKB([]byte{0x01, 0x10}); err != ErrIncorrectGeometry {
+		t.Errorf("twkb, empty point should return incorrect geometry error, got %v", err)
+	}
+}
+
+func TestPathToTWKB(t *testing.T) {
+	for _, tc := range postgisTWKB {
+		if !tc.encode {
+			continue
+		}
+
+		if data := tc.input.ToTWKB(tc.precision); !bytes.Equal(data, tc.data) {
+			t.Errorf("twkb, %s: expected %x, got %x", tc.sql, tc.data, data)
+		}
+	}
+
+	if data := NewPath().ToTWKB(5); !bytes.Equal(data, []byte{0xa2, 0x10}) {
+		t.Errorf("twkb, empty encoding incorrect, got %x", data)
+	}
+
+	// round trip
+	route := NewPath().
+		Push(NewPoint(-122.41942, 37.77493)).
+		Push(NewPoint(-122.41866, 37.77551)).
+		Push(NewPoint(-122.41758, 37.77637))
+
+	for _, precision := range []int{3, 5, 7} {
+		decoded, err := NewPathFromTWKB(route.ToTWKB(precision))
+		if err != nil {
+			t.Fatalf("twkb, decode error: %v", err)
+		}
+
+		if !decoded.ApproxEquals(route, math.Pow10(-precision)) {
+			t.Errorf("twkb, round trip with precision %d incorrect, got %v", precision, decoded)
+		}
+	}
+}
+
+func TestPointToTWKB(t *testing.T) {
+	if data := NewPoint(1, 2).ToTWKB(0); !bytes.Equal(data, []byte{0x01, 0x00, 0x02, 0x04}) {
+		t.Errorf("twkb, point encoding incorrect, got %x", data)
+	}
+
+	p, err := NewPointFromTWKB(NewPoint(-122.41942, 37.77493).ToTWKB(5))
+	if err != nil || !p.Equals(NewPoint(-122.41942, 37.77493)) {
+		t.Errorf("twkb, point round trip incorrect, got %v %v", p, err)
+	}
+}
+
+func TestTWKBPrecisionPanic(t *testing.T) {
+	for _, precision := range []int{-9, 8} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("twkb, precision %d should panic", precision)
+				}
+			}()
+
+			NewPath().ToTWKB(precision)
+		}()
+	}
+}
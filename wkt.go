@@ -0,0 +1,319 @@
+package geo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NewGeometryFromWKT creates the geometry of the matching type from WKT, ie. a POINT
+// becomes a *Point, a LINESTRING a *Path, a POLYGON a *Polygon, a MULTILINESTRING a MultiPath
+// and a GEOMETRYCOLLECTION a Collection. Keywords are case insensitive and EMPTY geometries,
+// except points, are supported. Any extra values of the positions, like elevation,
+// are ignored. Errors include the position and the full input.
+func NewGeometryFromWKT(wkt string) (Geometry, error) {
+	p := &wktParser{wkt: wkt}
+
+	g, err := p.geometry()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipSpace(); p.pos != len(p.wkt) {
+		return nil, p.errorf("unexpected text after geometry")
+	}
+
+	return g, nil
+}
+
+// wktParser is a recursive descent parser of WKT geometries.
+type wktParser struct {
+	wkt string
+	pos int
+}
+
+func (p *wktParser) geometry() (Geometry, error) {
+	start := p.pos
+	keyword := p.keyword()
+
+	switch keyword {
+	case "POINT":
+		if p.empty() {
+			p.pos = start
+			return nil, p.errorf("empty points are not supported")
+		}
+
+		points, err := p.points()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(points) != 1 {
+			p.pos = start
+			return nil, p.errorf("point must have one position")
+		}
+
+		return &points[0], nil
+	case "LINESTRING":
+		if p.empty() {
+			return NewPath(), nil
+		}
+
+		points, err := p.points()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewPath().SetPoints(points), nil
+	case "POLYGON":
+		if p.empty() {
+			return NewPolygon(NewPath()), nil
+		}
+
+		polygon, err := p.polygon()
+		if err != nil {
+			return nil, err
+		}
+
+		return polygon, nil
+	case "MULTIPOINT":
+		if p.empty() {
+			return MultiPoint{}, nil
+		}
+
+		mp, err := p.multiPoint()
+		if err != nil {
+			return nil, err
+		}
+
+		return mp, nil
+	case "MULTILINESTRING":
+		mp := MultiPath{}
+		if p.empty() {
+			return mp, nil
+		}
+
+		err := p.list(func() error {
+			if p.empty() {
+				mp = append(mp, NewPath())
+				return nil
+			}
+
+			points, err := p.points()
+			mp = append(mp, NewPath().SetPoints(points))
+			return err
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return mp, nil
+	case "MULTIPOLYGON":
+		mp := MultiPolygon{}
+		if p.empty() {
+			return mp, nil
+		}
+
+		err := p.list(func() error {
+			polygon, err := p.polygon()
+			mp = append(mp, polygon)
+			return err
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return mp, nil
+	case "GEOMETRYCOLLECTION":
+		c := Collection{}
+		if p.empty() {
+			return c, nil
+		}
+
+		err := p.list(func() error {
+			g, err := p.geometry()
+			c = append(c, g)
+			return err
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	}
+
+	p.pos = start
+	return nil, p.errorf("unsupported geometry type %q", keyword)
+}
+
+// polygon parses a list of rings, exterior first.
+func (p *wktParser) polygon() (*Polygon, error) {
+	var rings []*Path
+	err := p.list(func() error {
+		points, err := p.points()
+		rings = append(rings, NewPath().SetPoints(points))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPolygon(rings[0], rings[1:]...), nil
+}
+
+// multiPoint parses the points with or without parentheses around each one,
+// eg. (10 40,40 30) and ((10 40),(40 30)) are both common.
+func (p *wktParser) multiPoint() (MultiPoint, error) {
+	mp := MultiPoint{}
+	err := p.list(func() error {
+		if p.skipSpace(); p.peek() == '(' {
+			points, err := p.points()
+			if err == nil && len(points) != 1 {
+				err = p.errorf("multipoint member must have one position")
+			}
+
+			mp = append(mp, points...)
+			return err
+		}
+
+		point, err := p.position()
+		mp = append(mp, point)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mp, nil
+}
+
+// points parses a list of positions, eg. (30 10,10 30,40 40).
+func (p *wktParser) points() ([]Point, error) {
+	var points []Point
+	err := p.list(func() error {
+		point, err := p.position()
+		points = append(points, point)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// list parses a parenthesized, comma separated, non empty list calling
+// the function for each item.
+func (p *wktParser) list(item func() error) error {
+	if err := p.expect('('); err != nil {
+		return err
+	}
+
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return nil
+		default:
+			return p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+// position parses the space separated coordinates of a point,
+// values after the first two are ignored.
+func (p *wktParser) position() (Point, error) {
+	var point Point
+
+	count := 0
+	for {
+		p.skipSpace()
+		start := p.pos
+		for p.pos < len(p.wkt) && !strings.ContainsRune(" \t\r\n,()", rune(p.wkt[p.pos])) {
+			p.pos++
+		}
+
+		if start == p.pos {
+			break
+		}
+
+		v, err := strconv.ParseFloat(p.wkt[start:p.pos], 64)
+		if err != nil {
+			p.pos = start
+			return point, p.errorf("invalid coordinate")
+		}
+
+		if count < 2 {
+			point[count] = v
+		}
+		count++
+	}
+
+	if count < 2 {
+		return point, p.errorf("position must have at least 2 coordinates")
+	}
+
+	return point, nil
+}
+
+// keyword returns the next word in upper case.
+func (p *wktParser) keyword() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.wkt) && (p.wkt[p.pos] >= 'a' && p.wkt[p.pos] <= 'z' || p.wkt[p.pos] >= 'A' && p.wkt[p.pos] <= 'Z') {
+		p.pos++
+	}
+
+	return strings.ToUpper(p.wkt[start:p.pos])
+}
+
+// empty returns true, and consumes it, if the next word is EMPTY.
+func (p *wktParser) empty() bool {
+	start := p.pos
+	if p.keyword() == "EMPTY" {
+		return true
+	}
+
+	p.pos = start
+	return false
+}
+
+func (p *wktParser) expect(c byte) error {
+	if p.skipSpace(); p.peek() != c {
+		return p.errorf("expected '%c'", c)
+	}
+
+	p.pos++
+	return nil
+}
+
+func (p *wktParser) peek() byte {
+	if p.pos >= len(p.wkt) {
+		return 0
+	}
+
+	return p.wkt[p.pos]
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.wkt) && strings.ContainsRune(" \t\r\n", rune(p.wkt[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *wktParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("geo: invalid wkt, %s at position %d: %q", fmt.Sprintf(format, args...), p.pos, p.wkt)
+}
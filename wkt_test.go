@@ -0,0 +1,90 @@
+package geo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewGeometryFromWKT(t *testing.T) {
+	cases := []struct {
+		wkt      string
+		expected string
+	}{
+		{"POINT(1 2)", "POINT(1 2)"},
+		{"point ( 1.5  -2 )", "POINT(1.5 -2)"},
+		{"POINT(1 2 3)", "POINT(1 2)"},
+		{"LINESTRING(30 10,10 30,40 40)", "LINESTRING(30 10,10 30,40 40)"},
+		{"LINESTRING (30 10, 10 30, 40 40)", "LINESTRING(30 10,10 30,40 40)"},
+		{"LINESTRING EMPTY", "LINESTRING EMPTY"},
+		{"POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))", "POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))"},
+		{"POLYGON EMPTY", "POLYGON EMPTY"},
+		{"MULTIPOINT(10 40,40 30)", "MULTIPOINT(10 40,40 30)"},
+		{"MULTIPOINT((10 40),(40 30))", "MULTIPOINT(10 40,40 30)"},
+		{"MULTIPOINT EMPTY", "MULTIPOINT EMPTY"},
+		{"MULTILINESTRING((10 10,20 20),(40 40,30 30))", "MULTILINESTRING((10 10,20 20),(40 40,30 30))"},
+		{"MULTILINESTRING(EMPTY,(10 10,20 20))", "MULTILINESTRING(EMPTY,(10 10,20 20))"},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2),(2.1 2.1,2.2 2.1,2.2 2.2,2.1 2.1)))",
+			"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2),(2.1 2.1,2.2 2.1,2.2 2.2,2.1 2.1)))"},
+		{"GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10))", "GEOMETRYCOLLECTION(POINT(4 6),LINESTRING(4 6,7 10))"},
+		{"GEOMETRYCOLLECTION EMPTY", "GEOMETRYCOLLECTION EMPTY"},
+		{"  GeometryCollection ( MultiPoint EMPTY , Point (1e3 2) )  ", "GEOMETRYCOLLECTION(MULTIPOINT EMPTY,POINT(1000 2))"},
+	}
+
+	for _, tc := range cases {
+		g, err := NewGeometryFromWKT(tc.wkt)
+		if err != nil {
+			t.Errorf("wkt, %s error: %v", tc.wkt, err)
+			continue
+		}
+
+		if wkt := g.ToWKT(); wkt != tc.expected {
+			t.Errorf("wkt, %s expected %s, got %s", tc.wkt, tc.expected, wkt)
+		}
+	}
+
+	// types
+	g, _ := NewGeometryFromWKT("MULTILINESTRING((10 10,20 20))")
+	if _, ok := g.(MultiPath); !ok {
+		t.Errorf("wkt, multilinestring should be a multipath, got %T", g)
+	}
+
+	g, _ = NewGeometryFromWKT("POLYGON((0 0,4 0,4 4,0 0))")
+	if _, ok := g.(*Polygon); !ok {
+		t.Errorf("wkt, polygon should be a polygon, got %T", g)
+	}
+}
+
+func TestNewGeometryFromWKTErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"POINT",
+		"POINT EMPTY",
+		"POINT(1)",
+		"POINT(1 2,3 4)",
+		"POINT(1 a)",
+		"POINT(1 2",
+		"POINT(1 2) extra",
+		"LINESTRING()",
+		"LINESTRING(1 2;3 4)",
+		"POLYGON(1 2,3 4)",
+		"MULTIPOINT((1 2,3 4))",
+		"CIRCLE(1 2)",
+		"GEOMETRYCOLLECTION(POINT(1 2),CIRCLE(1 2))",
+	}
+
+	for _, wkt := range cases {
+		g, err := NewGeometryFromWKT(wkt)
+		if err == nil {
+			t.Errorf("wkt, %q should be an error, got %v", wkt, g)
+			continue
+		}
+
+		if g != nil {
+			t.Errorf("wkt, %q geometry should be nil on error, got %v", wkt, g)
+		}
+
+		if !strings.Contains(err.Error(), "geo: invalid wkt") || !strings.Contains(err.Error(), wkt) {
+			t.Errorf("wkt, %q error should include the input, got %v", wkt, err)
+		}
+	}
+}